@@ -0,0 +1,260 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/types"
+	"github.com/google/uuid"
+)
+
+type paper struct {
+	staff   []string
+	stories []types.Story
+	photos  []types.Photo
+}
+
+// Memory is a store which is lost on restart
+type Memory struct {
+	papers map[string]*paper
+	mutex  *sync.RWMutex
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		papers: make(map[string]*paper),
+		mutex:  &sync.RWMutex{},
+	}
+}
+
+// paper must be called with the mutex held
+func (m *Memory) paper(name string) (*paper, error) {
+	if p, ok := m.papers[name]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("Newspaper %s %w", name, types.ErrNotFound)
+}
+
+func (m *Memory) AddNewspaper(name string) (types.Newspaper, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.papers[name]; ok {
+		return types.Newspaper{}, fmt.Errorf("Newspaper %s %w", name, types.ErrExists)
+	}
+	m.papers[name] = &paper{}
+	return types.Newspaper{Name: name}, nil
+}
+
+func (m *Memory) DeleteNewspaper(name string) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.paper(name); err != nil {
+		return false, err
+	}
+	delete(m.papers, name)
+	return true, nil
+}
+
+func (m *Memory) Newspapers() ([]types.Newspaper, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	names := make([]string, 0, len(m.papers))
+	for name := range m.papers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret := make([]types.Newspaper, 0, len(names))
+	for _, name := range names {
+		ret = append(ret, types.Newspaper{Name: name})
+	}
+	return ret, nil
+}
+
+func (m *Memory) Newspaper(name string) (types.Newspaper, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if _, err := m.paper(name); err != nil {
+		return types.Newspaper{}, err
+	}
+	return types.Newspaper{Name: name}, nil
+}
+
+func (m *Memory) AddStaff(newspaper string, name string) (types.Staff, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return types.Staff{}, err
+	}
+	for _, s := range p.staff {
+		if s == name {
+			return types.Staff{}, fmt.Errorf("Staff %s at %s %w", name, newspaper, types.ErrExists)
+		}
+	}
+	p.staff = append(p.staff, name)
+	sort.Strings(p.staff)
+	return types.Staff{Newspaper: newspaper, Name: name}, nil
+}
+
+func (m *Memory) DeleteStaff(newspaper string, name string) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return false, err
+	}
+	for i, s := range p.staff {
+		if s == name {
+			p.staff = append(p.staff[:i], p.staff[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("Staff %s at %s %w", name, newspaper, types.ErrNotFound)
+}
+
+func (m *Memory) Staff(newspaper string) ([]types.Staff, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]types.Staff, 0, len(p.staff))
+	for _, s := range p.staff {
+		ret = append(ret, types.Staff{Newspaper: newspaper, Name: s})
+	}
+	return ret, nil
+}
+
+func (m *Memory) AddStory(newspaper string, headline string, story string) (types.Story, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return types.Story{}, err
+	}
+	s := types.Story{
+		UUID:      uuid.New().String(),
+		Newspaper: newspaper,
+		Headline:  headline,
+		Story:     story,
+	}
+	p.stories = append(p.stories, s)
+	return s, nil
+}
+
+func (m *Memory) DeleteStory(newspaper string, id string) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return false, err
+	}
+	for i, s := range p.stories {
+		if s.UUID == id {
+			p.stories = append(p.stories[:i], p.stories[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("Story %s at %s %w", id, newspaper, types.ErrNotFound)
+}
+
+func (m *Memory) Stories(newspaper string) ([]types.Story, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return nil, err
+	}
+	return append([]types.Story{}, p.stories...), nil
+}
+
+func (m *Memory) Story(id string) (types.Story, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, p := range m.papers {
+		for _, s := range p.stories {
+			if s.UUID == id {
+				return s, nil
+			}
+		}
+	}
+	return types.Story{}, fmt.Errorf("Story %s %w", id, types.ErrNotFound)
+}
+
+func (m *Memory) AddPhoto(newspaper string, caption string, filename string) (types.Photo, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return types.Photo{}, err
+	}
+	ph := types.Photo{
+		UUID:      uuid.New().String(),
+		Newspaper: newspaper,
+		Caption:   caption,
+		Filename:  filename,
+	}
+	p.photos = append(p.photos, ph)
+	return ph, nil
+}
+
+func (m *Memory) DeletePhoto(newspaper string, id string) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return false, err
+	}
+	for i, ph := range p.photos {
+		if ph.UUID == id {
+			p.photos = append(p.photos[:i], p.photos[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("Photo %s at %s %w", id, newspaper, types.ErrNotFound)
+}
+
+func (m *Memory) Photos(newspaper string) ([]types.Photo, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	p, err := m.paper(newspaper)
+	if err != nil {
+		return nil, err
+	}
+	return append([]types.Photo{}, p.photos...), nil
+}
+
+func (m *Memory) Photo(id string) (types.Photo, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, p := range m.papers {
+		for _, ph := range p.photos {
+			if ph.UUID == id {
+				return ph, nil
+			}
+		}
+	}
+	return types.Photo{}, fmt.Errorf("Photo %s %w", id, types.ErrNotFound)
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
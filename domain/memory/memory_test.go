@@ -0,0 +1,14 @@
+package memory_test
+
+import (
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/memory"
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/storetest"
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/types"
+	. "github.com/onsi/ginkgo"
+)
+
+var _ = Describe("Memory", func() {
+	storetest.StoreSpecs(func() types.Store {
+		return memory.NewMemory()
+	})
+})
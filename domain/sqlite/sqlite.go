@@ -0,0 +1,286 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/types"
+	"github.com/google/uuid"
+	// the driver needs cgo, the Dockerfile and releases build with it
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS newspaper (
+	name TEXT PRIMARY KEY
+);
+CREATE TABLE IF NOT EXISTS staff (
+	newspaper TEXT NOT NULL REFERENCES newspaper(name) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	PRIMARY KEY (newspaper, name)
+);
+CREATE TABLE IF NOT EXISTS story (
+	uuid TEXT PRIMARY KEY,
+	newspaper TEXT NOT NULL REFERENCES newspaper(name) ON DELETE CASCADE,
+	headline TEXT NOT NULL,
+	story TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS photo (
+	uuid TEXT PRIMARY KEY,
+	newspaper TEXT NOT NULL REFERENCES newspaper(name) ON DELETE CASCADE,
+	caption TEXT NOT NULL,
+	filename TEXT NOT NULL
+);
+`
+
+// Sqlite is a store kept in a sqlite database file
+type Sqlite struct {
+	db *sql.DB
+}
+
+// NewSqlite opens the database at path, creating the tables if needed
+func NewSqlite(path string) (*Sqlite, error) {
+	db, err := sql.Open("sqlite3", dsn(path))
+	if err != nil {
+		return nil, err
+	}
+	// sqlite has a single writer, one connection avoids busy errors between our own goroutines
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Sqlite{db: db}, nil
+}
+
+// dsn is the uri for the file at path, escaped so a ? or # in it isn't taken as the query or fragment
+func dsn(path string) string {
+	u := url.URL{
+		Scheme:   "file",
+		Path:     path,
+		RawQuery: "_foreign_keys=on&_busy_timeout=5000",
+	}
+	return u.String()
+}
+
+// changed turns no rows affected into err
+func changed(res sql.Result, err error, notFound error) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, notFound
+	}
+	return true, nil
+}
+
+// exists returns ErrNotFound for a missing newspaper, so lists of an unknown one error as in memory
+func (s *Sqlite) exists(newspaper string) error {
+	var name string
+	err := s.db.QueryRow(`SELECT name FROM newspaper WHERE name = ?`, newspaper).Scan(&name)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("Newspaper %s %w", newspaper, types.ErrNotFound)
+	}
+	return err
+}
+
+func (s *Sqlite) AddNewspaper(name string) (types.Newspaper, error) {
+	res, err := s.db.Exec(`INSERT OR IGNORE INTO newspaper (name) VALUES (?)`, name)
+	if _, err := changed(res, err, fmt.Errorf("Newspaper %s %w", name, types.ErrExists)); err != nil {
+		return types.Newspaper{}, err
+	}
+	return types.Newspaper{Name: name}, nil
+}
+
+func (s *Sqlite) DeleteNewspaper(name string) (bool, error) {
+	res, err := s.db.Exec(`DELETE FROM newspaper WHERE name = ?`, name)
+	return changed(res, err, fmt.Errorf("Newspaper %s %w", name, types.ErrNotFound))
+}
+
+func (s *Sqlite) Newspapers() ([]types.Newspaper, error) {
+	rows, err := s.db.Query(`SELECT name FROM newspaper ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make([]types.Newspaper, 0)
+	for rows.Next() {
+		var n types.Newspaper
+		if err := rows.Scan(&n.Name); err != nil {
+			return nil, err
+		}
+		ret = append(ret, n)
+	}
+	return ret, rows.Err()
+}
+
+func (s *Sqlite) Newspaper(name string) (types.Newspaper, error) {
+	if err := s.exists(name); err != nil {
+		return types.Newspaper{}, err
+	}
+	return types.Newspaper{Name: name}, nil
+}
+
+func (s *Sqlite) AddStaff(newspaper string, name string) (types.Staff, error) {
+	if err := s.exists(newspaper); err != nil {
+		return types.Staff{}, err
+	}
+	res, err := s.db.Exec(`INSERT OR IGNORE INTO staff (newspaper, name) VALUES (?, ?)`, newspaper, name)
+	if _, err := changed(res, err, fmt.Errorf("Staff %s at %s %w", name, newspaper, types.ErrExists)); err != nil {
+		return types.Staff{}, err
+	}
+	return types.Staff{Newspaper: newspaper, Name: name}, nil
+}
+
+func (s *Sqlite) DeleteStaff(newspaper string, name string) (bool, error) {
+	if err := s.exists(newspaper); err != nil {
+		return false, err
+	}
+	res, err := s.db.Exec(`DELETE FROM staff WHERE newspaper = ? AND name = ?`, newspaper, name)
+	return changed(res, err, fmt.Errorf("Staff %s at %s %w", name, newspaper, types.ErrNotFound))
+}
+
+func (s *Sqlite) Staff(newspaper string) ([]types.Staff, error) {
+	if err := s.exists(newspaper); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.Query(`SELECT newspaper, name FROM staff WHERE newspaper = ? ORDER BY name`, newspaper)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make([]types.Staff, 0)
+	for rows.Next() {
+		var st types.Staff
+		if err := rows.Scan(&st.Newspaper, &st.Name); err != nil {
+			return nil, err
+		}
+		ret = append(ret, st)
+	}
+	return ret, rows.Err()
+}
+
+func (s *Sqlite) AddStory(newspaper string, headline string, story string) (types.Story, error) {
+	if err := s.exists(newspaper); err != nil {
+		return types.Story{}, err
+	}
+	st := types.Story{
+		UUID:      uuid.New().String(),
+		Newspaper: newspaper,
+		Headline:  headline,
+		Story:     story,
+	}
+	_, err := s.db.Exec(`INSERT INTO story (uuid, newspaper, headline, story) VALUES (?, ?, ?, ?)`, st.UUID, st.Newspaper, st.Headline, st.Story)
+	if err != nil {
+		return types.Story{}, err
+	}
+	return st, nil
+}
+
+func (s *Sqlite) DeleteStory(newspaper string, id string) (bool, error) {
+	if err := s.exists(newspaper); err != nil {
+		return false, err
+	}
+	res, err := s.db.Exec(`DELETE FROM story WHERE newspaper = ? AND uuid = ?`, newspaper, id)
+	return changed(res, err, fmt.Errorf("Story %s at %s %w", id, newspaper, types.ErrNotFound))
+}
+
+func (s *Sqlite) Stories(newspaper string) ([]types.Story, error) {
+	if err := s.exists(newspaper); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.Query(`SELECT uuid, newspaper, headline, story FROM story WHERE newspaper = ? ORDER BY rowid`, newspaper)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make([]types.Story, 0)
+	for rows.Next() {
+		var st types.Story
+		if err := rows.Scan(&st.UUID, &st.Newspaper, &st.Headline, &st.Story); err != nil {
+			return nil, err
+		}
+		ret = append(ret, st)
+	}
+	return ret, rows.Err()
+}
+
+func (s *Sqlite) Story(id string) (types.Story, error) {
+	var st types.Story
+	err := s.db.QueryRow(`SELECT uuid, newspaper, headline, story FROM story WHERE uuid = ?`, id).
+		Scan(&st.UUID, &st.Newspaper, &st.Headline, &st.Story)
+	if err == sql.ErrNoRows {
+		return types.Story{}, fmt.Errorf("Story %s %w", id, types.ErrNotFound)
+	}
+	return st, err
+}
+
+func (s *Sqlite) AddPhoto(newspaper string, caption string, filename string) (types.Photo, error) {
+	if err := s.exists(newspaper); err != nil {
+		return types.Photo{}, err
+	}
+	ph := types.Photo{
+		UUID:      uuid.New().String(),
+		Newspaper: newspaper,
+		Caption:   caption,
+		Filename:  filename,
+	}
+	_, err := s.db.Exec(`INSERT INTO photo (uuid, newspaper, caption, filename) VALUES (?, ?, ?, ?)`, ph.UUID, ph.Newspaper, ph.Caption, ph.Filename)
+	if err != nil {
+		return types.Photo{}, err
+	}
+	return ph, nil
+}
+
+func (s *Sqlite) DeletePhoto(newspaper string, id string) (bool, error) {
+	if err := s.exists(newspaper); err != nil {
+		return false, err
+	}
+	res, err := s.db.Exec(`DELETE FROM photo WHERE newspaper = ? AND uuid = ?`, newspaper, id)
+	return changed(res, err, fmt.Errorf("Photo %s at %s %w", id, newspaper, types.ErrNotFound))
+}
+
+func (s *Sqlite) Photos(newspaper string) ([]types.Photo, error) {
+	if err := s.exists(newspaper); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.Query(`SELECT uuid, newspaper, caption, filename FROM photo WHERE newspaper = ? ORDER BY rowid`, newspaper)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make([]types.Photo, 0)
+	for rows.Next() {
+		var ph types.Photo
+		if err := rows.Scan(&ph.UUID, &ph.Newspaper, &ph.Caption, &ph.Filename); err != nil {
+			return nil, err
+		}
+		ret = append(ret, ph)
+	}
+	return ret, rows.Err()
+}
+
+func (s *Sqlite) Photo(id string) (types.Photo, error) {
+	var ph types.Photo
+	err := s.db.QueryRow(`SELECT uuid, newspaper, caption, filename FROM photo WHERE uuid = ?`, id).
+		Scan(&ph.UUID, &ph.Newspaper, &ph.Caption, &ph.Filename)
+	if err == sql.ErrNoRows {
+		return types.Photo{}, fmt.Errorf("Photo %s %w", id, types.ErrNotFound)
+	}
+	return ph, err
+}
+
+func (s *Sqlite) Close() error {
+	return s.db.Close()
+}
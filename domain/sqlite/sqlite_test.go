@@ -0,0 +1,72 @@
+package sqlite_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/sqlite"
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/storetest"
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sqlite", func() {
+	var (
+		dir string
+	)
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "sqlite")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	storetest.StoreSpecs(func() types.Store {
+		store, err := sqlite.NewSqlite(filepath.Join(dir, "domain.db"))
+		Expect(err).To(BeNil())
+		return store
+	})
+
+	Context("Reopening", func() {
+		It("should keep what was stored", func() {
+			path := filepath.Join(dir, "domain.db")
+			store, err := sqlite.NewSqlite(path)
+			Expect(err).To(BeNil())
+			_, err = store.AddNewspaper("times")
+			Expect(err).To(BeNil())
+			story, err := store.AddStory("times", "Headline", "Story")
+			Expect(err).To(BeNil())
+			Expect(store.Close()).To(BeNil())
+
+			store, err = sqlite.NewSqlite(path)
+			Expect(err).To(BeNil())
+			defer store.Close()
+
+			stories, err := store.Stories("times")
+			Expect(err).To(BeNil())
+			Expect(stories).To(Equal([]types.Story{story}))
+		})
+	})
+
+	Context("Path with uri characters", func() {
+		It("should open the file named", func() {
+			path := filepath.Join(dir, "what? #1 100%.db")
+			store, err := sqlite.NewSqlite(path)
+			Expect(err).To(BeNil())
+			defer store.Close()
+
+			_, err = store.AddNewspaper("times")
+			Expect(err).To(BeNil())
+
+			_, err = os.Stat(path)
+			Expect(err).To(BeNil())
+			files, err := ioutil.ReadDir(dir)
+			Expect(err).To(BeNil())
+			Expect(files).To(HaveLen(1))
+		})
+	})
+})
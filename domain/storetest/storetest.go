@@ -0,0 +1,183 @@
+// Package storetest is the behaviour every domain store shares, run from each store's suite
+package storetest
+
+import (
+	"errors"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// StoreSpecs adds the specs for a store, newStore is called before each one for an empty store
+func StoreSpecs(newStore func() types.Store) bool {
+	return Context("Store", func() {
+		var (
+			store types.Store
+		)
+
+		BeforeEach(func() {
+			store = newStore()
+			_, err := store.AddNewspaper("times")
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			Expect(store.Close()).To(BeNil())
+		})
+
+		Context("Newspapers", func() {
+			It("should add, list and delete", func() {
+				_, err := store.AddNewspaper("bugle")
+				Expect(err).To(BeNil())
+
+				papers, err := store.Newspapers()
+				Expect(err).To(BeNil())
+				Expect(papers).To(Equal([]types.Newspaper{{Name: "bugle"}, {Name: "times"}}))
+
+				paper, err := store.Newspaper("bugle")
+				Expect(err).To(BeNil())
+				Expect(paper.Name).To(Equal("bugle"))
+
+				ok, err := store.DeleteNewspaper("bugle")
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+
+				_, err = store.Newspaper("bugle")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+			It("should not add twice", func() {
+				_, err := store.AddNewspaper("times")
+				Expect(errors.Is(err, types.ErrExists)).To(BeTrue())
+			})
+			It("should not delete an unknown one", func() {
+				_, err := store.DeleteNewspaper("bugle")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+			It("should delete everything in it", func() {
+				_, err := store.AddStaff("times", "alice")
+				Expect(err).To(BeNil())
+				_, err = store.AddStory("times", "Headline", "Story")
+				Expect(err).To(BeNil())
+
+				_, err = store.DeleteNewspaper("times")
+				Expect(err).To(BeNil())
+				_, err = store.AddNewspaper("times")
+				Expect(err).To(BeNil())
+
+				staff, err := store.Staff("times")
+				Expect(err).To(BeNil())
+				Expect(staff).To(BeEmpty())
+				stories, err := store.Stories("times")
+				Expect(err).To(BeNil())
+				Expect(stories).To(BeEmpty())
+			})
+		})
+		Context("Staff", func() {
+			It("should add, list and delete", func() {
+				_, err := store.AddStaff("times", "bob")
+				Expect(err).To(BeNil())
+				_, err = store.AddStaff("times", "alice")
+				Expect(err).To(BeNil())
+
+				staff, err := store.Staff("times")
+				Expect(err).To(BeNil())
+				Expect(staff).To(Equal([]types.Staff{{Newspaper: "times", Name: "alice"}, {Newspaper: "times", Name: "bob"}}))
+
+				ok, err := store.DeleteStaff("times", "alice")
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+
+				_, err = store.DeleteStaff("times", "alice")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+			It("should not add twice", func() {
+				_, err := store.AddStaff("times", "alice")
+				Expect(err).To(BeNil())
+				_, err = store.AddStaff("times", "alice")
+				Expect(errors.Is(err, types.ErrExists)).To(BeTrue())
+			})
+			It("should need the newspaper", func() {
+				_, err := store.AddStaff("bugle", "alice")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+				_, err = store.Staff("bugle")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+		})
+		Context("Stories", func() {
+			It("should add with a UUID, list and delete by it", func() {
+				first, err := store.AddStory("times", "First", "Story")
+				Expect(err).To(BeNil())
+				Expect(first.UUID).To(HaveLen(36))
+
+				second, err := store.AddStory("times", "Second", "Story")
+				Expect(err).To(BeNil())
+				Expect(second.UUID).NotTo(Equal(first.UUID))
+
+				stories, err := store.Stories("times")
+				Expect(err).To(BeNil())
+				Expect(stories).To(Equal([]types.Story{first, second}))
+
+				ok, err := store.DeleteStory("times", first.UUID)
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+
+				stories, err = store.Stories("times")
+				Expect(err).To(BeNil())
+				Expect(stories).To(Equal([]types.Story{second}))
+			})
+			It("should only delete from its own newspaper", func() {
+				story, err := store.AddStory("times", "First", "Story")
+				Expect(err).To(BeNil())
+				_, err = store.AddNewspaper("bugle")
+				Expect(err).To(BeNil())
+
+				_, err = store.DeleteStory("bugle", story.UUID)
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+			It("should find one by UUID in any newspaper", func() {
+				story, err := store.AddStory("times", "First", "Story")
+				Expect(err).To(BeNil())
+
+				found, err := store.Story(story.UUID)
+				Expect(err).To(BeNil())
+				Expect(found).To(Equal(story))
+
+				_, err = store.Story("invalid")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+		})
+		Context("Photos", func() {
+			It("should add with a UUID, list and delete by it", func() {
+				photo, err := store.AddPhoto("times", "Caption", "photo.jpg")
+				Expect(err).To(BeNil())
+				Expect(photo.UUID).To(HaveLen(36))
+
+				photos, err := store.Photos("times")
+				Expect(err).To(BeNil())
+				Expect(photos).To(Equal([]types.Photo{photo}))
+
+				ok, err := store.DeletePhoto("times", photo.UUID)
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+
+				_, err = store.DeletePhoto("times", photo.UUID)
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+			It("should find one by UUID in any newspaper", func() {
+				photo, err := store.AddPhoto("times", "Caption", "photo.jpg")
+				Expect(err).To(BeNil())
+
+				found, err := store.Photo(photo.UUID)
+				Expect(err).To(BeNil())
+				Expect(found).To(Equal(photo))
+
+				_, err = store.Photo("invalid")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+			It("should need the newspaper", func() {
+				_, err := store.AddPhoto("bugle", "Caption", "photo.jpg")
+				Expect(errors.Is(err, types.ErrNotFound)).To(BeTrue())
+			})
+		})
+	})
+}
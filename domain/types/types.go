@@ -0,0 +1,62 @@
+package types
+
+import (
+	"errors"
+)
+
+// ErrNotFound is returned when a newspaper, staff member, story or photo doesn't exist
+var ErrNotFound = errors.New("not found")
+
+// ErrExists is returned when adding a newspaper or staff member which already exists
+var ErrExists = errors.New("already exists")
+
+// Newspaper is a domain, its name is what @HasRbacDomain checks
+type Newspaper struct {
+	Name string
+}
+
+type Staff struct {
+	Newspaper string
+	Name      string
+}
+
+type Story struct {
+	UUID      string
+	Newspaper string
+	Headline  string
+	Story     string
+}
+
+type Photo struct {
+	UUID      string
+	Newspaper string
+	Caption   string
+	Filename  string
+}
+
+// Store keeps the newspapers and everything in them
+// Deleting a newspaper deletes its staff, stories and photos
+type Store interface {
+	AddNewspaper(name string) (Newspaper, error)
+	DeleteNewspaper(name string) (bool, error)
+	Newspapers() ([]Newspaper, error)
+	Newspaper(name string) (Newspaper, error)
+
+	AddStaff(newspaper string, name string) (Staff, error)
+	DeleteStaff(newspaper string, name string) (bool, error)
+	Staff(newspaper string) ([]Staff, error)
+
+	// stories and photos get a generated UUID to delete them by
+	AddStory(newspaper string, headline string, story string) (Story, error)
+	DeleteStory(newspaper string, uuid string) (bool, error)
+	Stories(newspaper string) ([]Story, error)
+	// Story finds a story in any newspaper, so its newspaper can be checked
+	Story(uuid string) (Story, error)
+
+	AddPhoto(newspaper string, caption string, filename string) (Photo, error)
+	DeletePhoto(newspaper string, uuid string) (bool, error)
+	Photos(newspaper string) ([]Photo, error)
+	Photo(uuid string) (Photo, error)
+
+	Close() error
+}
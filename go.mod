@@ -6,8 +6,12 @@ require (
 	github.com/99designs/gqlgen v0.11.3
 	github.com/auth0/go-jwt-middleware v0.0.0-20200507191422-d30d7b9ece63
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
+	github.com/fsnotify/fsnotify v1.4.9
+	github.com/google/uuid v1.1.1
 	github.com/gorilla/handlers v1.4.2
+	github.com/hashicorp/golang-lru v0.5.0
 	github.com/iancoleman/strcase v0.0.0-20191112232945-16388991a334
+	github.com/mattn/go-sqlite3 v1.14.0
 	github.com/mikespook/gorbac v2.1.0+incompatible
 	github.com/namsral/flag v1.7.4-pre
 	github.com/onsi/ginkgo v1.13.0
@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+)
+
+// DomainLookup finds the domain an id belongs to, eg the newspaper a story is in
+type DomainLookup func(ctx context.Context, id string) (string, error)
+
+// DomainLookups are the HasRbacDomain lookups, finding stories and photos in the store
+func (r *Resolver) DomainLookups() map[model.DomainLookup]DomainLookup {
+	return map[model.DomainLookup]DomainLookup{
+		model.DomainLookupStory: func(ctx context.Context, id string) (string, error) {
+			story, err := r.Store.Story(id)
+			return story.Newspaper, err
+		},
+		model.DomainLookupPhoto: func(ctx context.Context, id string) (string, error) {
+			photo, err := r.Store.Photo(id)
+			return photo.Newspaper, err
+		},
+	}
+}
+
+// Domains finds every domain a HasRbacDomain directive guards
+// obj is the arguments or input the directive is on, path is dotted through nested inputs and
+// every element of a list is followed, with lookup each value found is an id mapped to its domain
+func Domains(ctx context.Context, obj interface{}, path string, lookup DomainLookup) ([]string, error) {
+	values, err := walk(obj, strings.Split(path, "."), path)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]string, 0, len(values))
+	for _, v := range values {
+		if lookup != nil {
+			if v, err = lookup(ctx, v); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+// walk follows path down through obj, returning each string at the end of it
+func walk(obj interface{}, path []string, field string) ([]string, error) {
+	switch v := obj.(type) {
+	case []interface{}:
+		ret := make([]string, 0, len(v))
+		for _, e := range v {
+			values, err := walk(e, path, field)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, values...)
+		}
+		return ret, nil
+	case map[string]interface{}:
+		if len(path) == 0 {
+			break
+		}
+		next, ok := v[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("Domain field %s not found", field)
+		}
+		return walk(next, path[1:], field)
+	case string:
+		if len(path) == 0 {
+			return []string{v}, nil
+		}
+	case nil:
+		// an optional input which wasn't given has nothing to check
+		return []string{}, nil
+	}
+	return nil, fmt.Errorf("Domain field %s is not a string", field)
+}
@@ -0,0 +1,79 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/memory"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domains", func() {
+	Context("Top level field", func() {
+		It("should find it", func() {
+			domains, err := graph.Domains(context.Background(), map[string]interface{}{"newspaper": "times"}, "newspaper", nil)
+			Expect(err).To(BeNil())
+			Expect(domains).To(Equal([]string{"times"}))
+		})
+	})
+	Context("Nested path", func() {
+		It("should follow it through inputs and lists", func() {
+			args := map[string]interface{}{
+				"input": []interface{}{
+					map[string]interface{}{"newspaper": map[string]interface{}{"name": "times"}},
+					map[string]interface{}{"newspaper": map[string]interface{}{"name": "mail"}},
+				},
+			}
+			domains, err := graph.Domains(context.Background(), args, "input.newspaper.name", nil)
+			Expect(err).To(BeNil())
+			Expect(domains).To(Equal([]string{"times", "mail"}))
+		})
+		It("should fail if it isn't there", func() {
+			_, err := graph.Domains(context.Background(), map[string]interface{}{"input": map[string]interface{}{}}, "input.newspaper", nil)
+			Expect(err).To(MatchError("Domain field input.newspaper not found"))
+		})
+		It("should fail if it doesn't end at a string", func() {
+			_, err := graph.Domains(context.Background(), map[string]interface{}{"input": map[string]interface{}{}}, "input", nil)
+			Expect(err).To(MatchError("Domain field input is not a string"))
+		})
+		It("should find nothing for an input not given", func() {
+			domains, err := graph.Domains(context.Background(), map[string]interface{}{"input": nil}, "input.newspaper", nil)
+			Expect(err).To(BeNil())
+			Expect(domains).To(BeEmpty())
+		})
+	})
+	Context("Lookup", func() {
+		It("should map each id to its domain", func() {
+			lookup := func(ctx context.Context, id string) (string, error) {
+				return "paper-of-" + id, nil
+			}
+			domains, err := graph.Domains(context.Background(), map[string]interface{}{"uuids": []interface{}{"a", "b"}}, "uuids", lookup)
+			Expect(err).To(BeNil())
+			Expect(domains).To(Equal([]string{"paper-of-a", "paper-of-b"}))
+		})
+		It("should fail if the lookup does", func() {
+			lookup := func(ctx context.Context, id string) (string, error) {
+				return "", fmt.Errorf("Story %s not found", id)
+			}
+			_, err := graph.Domains(context.Background(), map[string]interface{}{"uuid": "a"}, "uuid", lookup)
+			Expect(err).To(MatchError("Story a not found"))
+		})
+		It("should find stories and photos in the store", func() {
+			resolver := &graph.Resolver{Store: memory.NewMemory()}
+			_, err := resolver.Store.AddNewspaper("times")
+			Expect(err).To(BeNil())
+			photo, err := resolver.Store.AddPhoto("times", "Caption", "photo.jpg")
+			Expect(err).To(BeNil())
+
+			domain, err := resolver.DomainLookups()[model.DomainLookupPhoto](context.Background(), photo.UUID)
+			Expect(err).To(BeNil())
+			Expect(domain).To(Equal("times"))
+
+			_, err = resolver.DomainLookups()[model.DomainLookupStory](context.Background(), photo.UUID)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
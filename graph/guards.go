@@ -0,0 +1,251 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	"github.com/iancoleman/strcase"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	HasRbac          = "HasRbac"
+	HasRbacDomain    = "HasRbacDomain"
+	MaskUnlessRbac   = "MaskUnlessRbac"
+	FilterRbacDomain = "FilterRbacDomain"
+	HasRbacResult    = "HasRbacResult"
+)
+
+// Guard is a field or argument protected by one of the rbac directives
+type Guard struct {
+	// Field is where the directive is, eg Mutation.upsertRole(input)
+	Field string
+	// Rbac is the enum value the directive asks for, there is a guard for rbac and each of allOf
+	Rbac string
+	// AnyOf is set rather than Rbac for the directive's anyOf, any one of them passes
+	AnyOf []string
+	// Domain is set for HasRbacDomain, the permission is then granted within the domain
+	Domain bool
+}
+
+// Permissions are the permissions as Check or CheckDomain will ask for them, any one of them passes the guard
+func (g Guard) Permissions() []string {
+	if len(g.AnyOf) == 0 {
+		return []string{strcase.ToKebab(g.Rbac)}
+	}
+	ret := make([]string, 0, len(g.AnyOf))
+	for _, r := range g.AnyOf {
+		ret = append(ret, strcase.ToKebab(r))
+	}
+	return ret
+}
+
+func (g Guard) String() string {
+	permissions := strings.Join(g.Permissions(), " or ")
+	if g.Domain {
+		return fmt.Sprintf("%s needs %s in <domain>", g.Field, permissions)
+	}
+	return fmt.Sprintf("%s needs %s", g.Field, permissions)
+}
+
+// Guards finds every use of the rbac directives in the schema
+func Guards(schema *ast.Schema) []Guard {
+	ret := make([]Guard, 0)
+
+	names := make([]string, 0, len(schema.Types))
+	for name := range schema.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		def := schema.Types[name]
+		if def.BuiltIn {
+			continue
+		}
+		for _, field := range def.Fields {
+			location := fmt.Sprintf("%s.%s", def.Name, field.Name)
+			ret = append(ret, directiveGuards(location, field.Directives)...)
+
+			for _, arg := range field.Arguments {
+				ret = append(ret, directiveGuards(fmt.Sprintf("%s(%s)", location, arg.Name), arg.Directives)...)
+			}
+		}
+	}
+
+	return ret
+}
+
+func directiveGuards(location string, directives ast.DirectiveList) []Guard {
+	ret := make([]Guard, 0)
+	for _, d := range directives {
+		var domain bool
+		switch d.Name {
+		case HasRbac:
+		case HasRbacDomain, FilterRbacDomain, HasRbacResult:
+			domain = true
+		case MaskUnlessRbac:
+			domain = d.Arguments.ForName("domainField") != nil
+		default:
+			continue
+		}
+		if arg := d.Arguments.ForName("rbac"); arg != nil && arg.Value != nil {
+			ret = append(ret, Guard{
+				Field:  location,
+				Rbac:   arg.Value.Raw,
+				Domain: domain,
+			})
+		}
+		if arg := d.Arguments.ForName("allOf"); arg != nil && arg.Value != nil {
+			for _, v := range arg.Value.Children {
+				ret = append(ret, Guard{
+					Field:  location,
+					Rbac:   v.Value.Raw,
+					Domain: domain,
+				})
+			}
+		}
+		if arg := d.Arguments.ForName("anyOf"); arg != nil && arg.Value != nil && len(arg.Value.Children) > 0 {
+			g := Guard{
+				Field:  location,
+				AnyOf:  make([]string, 0, len(arg.Value.Children)),
+				Domain: domain,
+			}
+			for _, v := range arg.Value.Children {
+				g.AnyOf = append(g.AnyOf, v.Value.Raw)
+			}
+			ret = append(ret, g)
+		}
+	}
+	return ret
+}
+
+// Misuse is an rbac directive used where it can't do what it is for
+type Misuse struct {
+	// Field is where the directive is, as for Guard
+	Field     string
+	Directive string
+	Reason    string
+}
+
+func (m Misuse) String() string {
+	return fmt.Sprintf("%s @%s %s", m.Field, m.Directive, m.Reason)
+}
+
+// Misuses finds every rbac directive in the schema which can't work where it is
+// eg masking a non null field to null, which fails the query rather than hiding the field,
+// or checking the result of a mutation, which has already happened by then, or asking for no permissions at all
+func Misuses(schema *ast.Schema) []Misuse {
+	ret := make([]Misuse, 0)
+
+	names := make([]string, 0, len(schema.Types))
+	for name := range schema.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		def := schema.Types[name]
+		if def.BuiltIn {
+			continue
+		}
+		mutation := schema.Mutation != nil && def.Name == schema.Mutation.Name
+		for _, field := range def.Fields {
+			location := fmt.Sprintf("%s.%s", def.Name, field.Name)
+			for _, d := range field.Directives {
+				if reason := fieldMisuse(field, d, mutation); reason != "" {
+					ret = append(ret, Misuse{Field: location, Directive: d.Name, Reason: reason})
+				}
+			}
+
+			for _, arg := range field.Arguments {
+				for _, d := range arg.Directives {
+					if reason := directiveMisuse(d); reason != "" {
+						ret = append(ret, Misuse{Field: fmt.Sprintf("%s(%s)", location, arg.Name), Directive: d.Name, Reason: reason})
+					}
+				}
+			}
+		}
+	}
+
+	return ret
+}
+
+// directiveMisuse is why the directive can't work wherever it is, empty if it can
+func directiveMisuse(d *ast.Directive) string {
+	switch d.Name {
+	case HasRbac, HasRbacDomain:
+		// the requirement is never met so every request would be denied
+		if !hasValue(d, "rbac") && !hasItems(d, "anyOf") && !hasItems(d, "allOf") {
+			return "has none of rbac, anyOf or allOf so denies every request"
+		}
+	}
+	return ""
+}
+
+// fieldMisuse is why the directive can't be on the field, empty if it can
+func fieldMisuse(field *ast.FieldDefinition, d *ast.Directive, mutation bool) string {
+	if reason := directiveMisuse(d); reason != "" {
+		return reason
+	}
+
+	switch d.Name {
+	case MaskUnlessRbac:
+		if field.Type.NonNull && !hasValue(d, "replacement") {
+			return "is on a non null field without a replacement"
+		}
+	case HasRbacResult:
+		if mutation {
+			return "is on a mutation, which would run before it is checked"
+		}
+	}
+	return ""
+}
+
+// hasValue is true if the directive was given the argument and it isn't null
+func hasValue(d *ast.Directive, name string) bool {
+	arg := d.Arguments.ForName(name)
+	return arg != nil && arg.Value != nil && arg.Value.Kind != ast.NullValue
+}
+
+// hasItems is true if the directive was given the list argument with something in it
+func hasItems(d *ast.Directive, name string) bool {
+	return hasValue(d, name) && len(d.Arguments.ForName(name).Value.Children) > 0
+}
+
+// Ungranted is every guard no role in the policy can pass
+func Ungranted(schema *ast.Schema, rbac types.RbacQuery) ([]Guard, error) {
+	roles, err := rbac.GetRoles(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := make(map[string]bool)
+	inDomain := make(map[string]bool)
+	for _, role := range roles {
+		for _, p := range role.Permissions {
+			granted[p] = true
+		}
+		for _, g := range role.Grants() {
+			inDomain[g.Permission] = true
+		}
+	}
+
+	ret := make([]Guard, 0)
+	for _, g := range Guards(schema) {
+		found := granted
+		if g.Domain {
+			found = inDomain
+		}
+		passed := false
+		for _, p := range g.Permissions() {
+			passed = passed || found[p]
+		}
+		if !passed {
+			ret = append(ret, g)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,164 @@
+package graph_test
+
+import (
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/graph"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/generated"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/dummy"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/gorbac"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var _ = Describe("Guards", func() {
+	var (
+		schema *ast.Schema
+	)
+	BeforeEach(func() {
+		schema = generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}}).Schema()
+	})
+
+	Context("Can find guards", func() {
+		It("should include arguments, fields and input fields", func() {
+			guards := graph.Guards(schema)
+
+			Expect(guards).To(ContainElement(graph.Guard{Field: "Mutation.upsertRole(input)", Rbac: "RBAC_MUTATE"}))
+			Expect(guards).To(ContainElement(graph.Guard{Field: "Mutation.save", Rbac: "RBAC_MUTATE"}))
+			Expect(guards).To(ContainElement(graph.Guard{Field: "AddStory.newspaper", Rbac: "MOD_STORY", Domain: true}))
+			Expect(guards).To(ContainElement(graph.Guard{Field: "Mutation.deleteStories(uuids)", AnyOf: []string{"MOD_STORY", "DEL_MEDIA"}, Domain: true}))
+		})
+		It("should include masked and filtered fields", func() {
+			guards := graph.Guards(schema)
+
+			Expect(guards).To(ContainElement(graph.Guard{Field: "Photo.filename", Rbac: "MOD_PHOTO", Domain: true}))
+			Expect(guards).To(ContainElement(graph.Guard{Field: "Query.newspapers", Rbac: "READ_NEWSPAPER", Domain: true}))
+		})
+		It("should include allOf and anyOf", func() {
+			schema, err := gqlparser.LoadSchema(&ast.Source{Input: `
+enum RBAC { MOD_STORY DEL_MEDIA READ_STORY }
+directive @HasRbac(rbac: RBAC, anyOf: [RBAC!], allOf: [RBAC!]) on FIELD_DEFINITION
+type Query {
+  stories: [String!]! @HasRbac(rbac: READ_STORY, allOf: [MOD_STORY], anyOf: [MOD_STORY, DEL_MEDIA])
+}`})
+			Expect(err).To(BeNil())
+
+			Expect(graph.Guards(schema)).To(Equal([]graph.Guard{
+				{Field: "Query.stories", Rbac: "READ_STORY"},
+				{Field: "Query.stories", Rbac: "MOD_STORY"},
+				{Field: "Query.stories", AnyOf: []string{"MOD_STORY", "DEL_MEDIA"}},
+			}))
+		})
+	})
+	Context("Misused directives", func() {
+		load := func(types string) *ast.Schema {
+			schema, err := gqlparser.LoadSchema(&ast.Source{Input: `
+enum RBAC { MOD_PHOTO READ_STORY }
+directive @MaskUnlessRbac(rbac: RBAC!, replacement: String, domainField: String) on FIELD_DEFINITION
+directive @HasRbacResult(rbac: RBAC!, domainField: String!) on FIELD_DEFINITION
+directive @HasRbac(rbac: RBAC, anyOf: [RBAC!], allOf: [RBAC!]) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+directive @HasRbacDomain(rbac: RBAC, anyOf: [RBAC!], allOf: [RBAC!], domainField: String) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+type Story { newspaper: String! }
+` + types})
+			Expect(err).To(BeNil())
+			return schema
+		}
+
+		It("should find none in the schema", func() {
+			Expect(graph.Misuses(schema)).To(BeEmpty())
+		})
+		It("should not mask a non null field without a replacement", func() {
+			Expect(graph.Misuses(load(`
+type Query {
+  filename: String! @MaskUnlessRbac(rbac: MOD_PHOTO)
+  caption: String! @MaskUnlessRbac(rbac: MOD_PHOTO, replacement: null)
+}`))).To(Equal([]graph.Misuse{
+				{Field: "Query.filename", Directive: "MaskUnlessRbac", Reason: "is on a non null field without a replacement"},
+				{Field: "Query.caption", Directive: "MaskUnlessRbac", Reason: "is on a non null field without a replacement"},
+			}))
+		})
+		It("should mask a nullable field or one with a replacement", func() {
+			Expect(graph.Misuses(load(`
+type Query {
+  filename: String @MaskUnlessRbac(rbac: MOD_PHOTO)
+  caption: String! @MaskUnlessRbac(rbac: MOD_PHOTO, replacement: "")
+}`))).To(BeEmpty())
+		})
+		It("should only check the result of a query", func() {
+			Expect(graph.Misuses(load(`
+type Query {
+  story(uuid: String!): Story! @HasRbacResult(rbac: READ_STORY, domainField: "newspaper")
+}
+type Mutation {
+  deleteStory(uuid: String!): Story! @HasRbacResult(rbac: READ_STORY, domainField: "newspaper")
+}`))).To(Equal([]graph.Misuse{
+				{Field: "Mutation.deleteStory", Directive: "HasRbacResult", Reason: "is on a mutation, which would run before it is checked"},
+			}))
+		})
+		It("should ask for some permission", func() {
+			Expect(graph.Misuses(load(`
+input AddStory {
+  newspaper: String! @HasRbacDomain(domainField: "newspaper")
+  headline: String! @HasRbac(rbac: READ_STORY)
+}
+type Query {
+  stories: [Story!]! @HasRbac
+  story(uuid: String! @HasRbac(anyOf: [], allOf: [])): Story!
+  photos: [Story!]! @HasRbac(rbac: null, anyOf: [READ_STORY])
+}
+type Mutation {
+  addStory(input: AddStory!): String!
+}`))).To(Equal([]graph.Misuse{
+				{Field: "AddStory.newspaper", Directive: "HasRbacDomain", Reason: "has none of rbac, anyOf or allOf so denies every request"},
+				{Field: "Query.stories", Directive: "HasRbac", Reason: "has none of rbac, anyOf or allOf so denies every request"},
+				{Field: "Query.story(uuid)", Directive: "HasRbac", Reason: "has none of rbac, anyOf or allOf so denies every request"},
+			}))
+		})
+	})
+	Context("Describes the permission", func() {
+		It("should be kebab case", func() {
+			Expect(graph.Guard{Field: "Mutation.save", Rbac: "RBAC_MUTATE"}.String()).To(Equal("Mutation.save needs rbac-mutate"))
+			Expect(graph.Guard{Field: "AddStory.newspaper", Rbac: "MOD_STORY", Domain: true}.String()).To(Equal("AddStory.newspaper needs mod-story in <domain>"))
+			Expect(graph.Guard{Field: "Mutation.deleteStories(uuids)", AnyOf: []string{"MOD_STORY", "DEL_MEDIA"}, Domain: true}.String()).To(Equal("Mutation.deleteStories(uuids) needs mod-story or del-media in <domain>"))
+		})
+	})
+	Context("Policy without permissions", func() {
+		It("should leave every guard ungranted", func() {
+			ungranted, err := graph.Ungranted(schema, &dummy.Dummy{})
+
+			Expect(err).To(BeNil())
+			Expect(ungranted).To(Equal(graph.Guards(schema)))
+		})
+	})
+	Context("Policy granting some permissions", func() {
+		It("should only list the rest", func() {
+			rbac, err := gorbac.NewRbac(strings.NewReader(`
+permissions:
+- rbac-mutate
+- mod-story
+- mod-photo
+roles:
+ rbac-rw:
+  permissions:
+  - rbac-mutate
+  - mod-photo
+ the-bugle-editor:
+  domains:
+   the-bugle:
+   - mod-story`))
+			Expect(err).To(BeNil())
+
+			ungranted, err := graph.Ungranted(schema, rbac)
+			Expect(err).To(BeNil())
+
+			Expect(ungranted).NotTo(ContainElement(graph.Guard{Field: "Mutation.save", Rbac: "RBAC_MUTATE"}))
+			Expect(ungranted).NotTo(ContainElement(graph.Guard{Field: "AddStory.newspaper", Rbac: "MOD_STORY", Domain: true}))
+			Expect(ungranted).To(ContainElement(graph.Guard{Field: "AddPhoto.newspaper", Rbac: "MOD_PHOTO", Domain: true}))
+			Expect(ungranted).To(ContainElement(graph.Guard{Field: "Query.jwt(token)", Rbac: "JWT_QUERY"}))
+			// any one of anyOf passes
+			Expect(ungranted).NotTo(ContainElement(graph.Guard{Field: "Mutation.deleteStories(uuids)", AnyOf: []string{"MOD_STORY", "DEL_MEDIA"}, Domain: true}))
+		})
+	})
+})
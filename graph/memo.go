@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+)
+
+type memoKey struct{}
+
+// decision is what the memo remembers, the user and so their roles are fixed for the operation
+type decision struct {
+	domain     string
+	hasDomain  bool
+	permission string
+}
+
+// Memo remembers check results for one operation
+// Fields resolve concurrently so it is safe for concurrent use
+type Memo struct {
+	mutex     sync.Mutex
+	decisions map[decision]bool
+}
+
+// WithMemo adds an empty memo to the context
+func WithMemo(ctx context.Context) context.Context {
+	return context.WithValue(ctx, memoKey{}, &Memo{
+		decisions: make(map[decision]bool),
+	})
+}
+
+// GetMemo returns the memo for the operation or nil if there isn't one
+func GetMemo(ctx context.Context) *Memo {
+	if m, ok := ctx.Value(memoKey{}).(*Memo); ok {
+		return m
+	}
+	return nil
+}
+
+// MemoOperation is gqlgen operation middleware giving each operation its own memo
+func MemoOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(WithMemo(ctx))
+}
+
+// Len is how many decisions are remembered
+func (m *Memo) Len() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return len(m.decisions)
+}
+
+func (m *Memo) check(d decision, check func() bool) bool {
+	if m == nil {
+		return check()
+	}
+
+	m.mutex.Lock()
+	granted, ok := m.decisions[d]
+	m.mutex.Unlock()
+	if ok {
+		return granted
+	}
+
+	// checked unlocked, a concurrent field asking the same gets the same answer
+	granted = check()
+
+	m.mutex.Lock()
+	m.decisions[d] = granted
+	m.mutex.Unlock()
+
+	return granted
+}
+
+// Check asks if the current user has permission, remembering the answer for the operation
+func Check(ctx context.Context, rbac types.Rbac, permission string) bool {
+	return GetMemo(ctx).check(decision{permission: permission}, func() bool {
+		return rbac.Check(GetCurrentUser(ctx).Roles, permission)
+	})
+}
+
+// CheckDomain asks if the current user has permission in domain, remembering the answer for the operation
+// The roles are checked in the global policy then in that of the token's tenant, which only grants within the tenant
+func CheckDomain(ctx context.Context, rbac types.Rbac, domain string, permission string) bool {
+	return GetMemo(ctx).check(decision{domain: domain, hasDomain: true, permission: permission}, func() bool {
+		user := GetCurrentUser(ctx)
+		if rbac.CheckDomain(user.Roles, &domain, permission) {
+			return true
+		}
+		return user.Tenant != "" && rbac.CheckTenant(user.Roles, user.Tenant, &domain, permission)
+	})
+}
@@ -0,0 +1,98 @@
+package graph_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/dummy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// counting counts the checks which reach the policy
+type counting struct {
+	dummy.Dummy
+	mutex  sync.Mutex
+	checks int
+}
+
+func (c *counting) Check(roles []string, permission string) bool {
+	c.mutex.Lock()
+	c.checks++
+	c.mutex.Unlock()
+	return c.Dummy.Check(roles, permission)
+}
+
+func (c *counting) CheckDomain(roles []string, domain *string, permission string) bool {
+	c.mutex.Lock()
+	c.checks++
+	c.mutex.Unlock()
+	return c.Dummy.CheckDomain(roles, domain, permission)
+}
+
+var _ = Describe("Memo", func() {
+	var (
+		rbac *counting
+	)
+	BeforeEach(func() {
+		rbac = &counting{}
+	})
+
+	Context("Within an operation", func() {
+		It("should check each permission once", func() {
+			ctx := graph.WithMemo(context.Background())
+
+			for i := 0; i < 3; i++ {
+				Expect(graph.Check(ctx, rbac, "RBAC_QUERY")).To(BeFalse())
+				Expect(graph.CheckDomain(ctx, rbac, "times", "MOD_STORY")).To(BeFalse())
+				Expect(graph.CheckDomain(ctx, rbac, "mail", "MOD_STORY")).To(BeFalse())
+			}
+
+			Expect(rbac.checks).To(Equal(3))
+			Expect(graph.GetMemo(ctx).Len()).To(Equal(3))
+		})
+		It("should be safe for concurrent fields", func() {
+			ctx := graph.WithMemo(context.Background())
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					graph.CheckDomain(ctx, rbac, "times", "MOD_STORY")
+				}()
+			}
+			wg.Wait()
+
+			Expect(graph.GetMemo(ctx).Len()).To(Equal(1))
+		})
+	})
+	Context("Without a memo", func() {
+		It("should check every time", func() {
+			ctx := context.Background()
+
+			Expect(graph.Check(ctx, rbac, "RBAC_QUERY")).To(BeFalse())
+			Expect(graph.Check(ctx, rbac, "RBAC_QUERY")).To(BeFalse())
+			Expect(rbac.checks).To(Equal(2))
+		})
+	})
+	Context("Operation middleware", func() {
+		It("should give each operation its own memo", func() {
+			memos := make([]*graph.Memo, 0)
+			next := func(ctx context.Context) graphql.ResponseHandler {
+				memos = append(memos, graph.GetMemo(ctx))
+				return nil
+			}
+
+			graph.MemoOperation(context.Background(), next)
+			graph.MemoOperation(context.Background(), next)
+
+			Expect(memos).To(HaveLen(2))
+			Expect(memos[0]).NotTo(BeNil())
+			Expect(memos[0]).NotTo(BeIdenticalTo(memos[1]))
+		})
+	})
+})
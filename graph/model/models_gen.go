@@ -15,9 +15,10 @@ type AddPhoto struct {
 }
 
 type AddRole struct {
-	Name        string    `json:"name"`
-	Permissions []*string `json:"permissions"`
-	Parents     []*string `json:"parents"`
+	Name            string    `json:"name"`
+	Permissions     []*string `json:"permissions"`
+	Parents         []*string `json:"parents"`
+	ExpectedVersion *int      `json:"expectedVersion"`
 }
 
 type AddStory struct {
@@ -32,12 +33,30 @@ type DeleteMedia struct {
 }
 
 type DeletePermission struct {
-	Name       string `json:"name"`
-	Permission string `json:"permission"`
+	Name            string `json:"name"`
+	Permission      string `json:"permission"`
+	ExpectedVersion *int   `json:"expectedVersion"`
 }
 
 type DeleteRole struct {
-	Name string `json:"name"`
+	Name            string `json:"name"`
+	ExpectedVersion *int   `json:"expectedVersion"`
+}
+
+type Grant struct {
+	Domain     string `json:"domain"`
+	Permission string `json:"permission"`
+}
+
+type GrantInput struct {
+	Domain     string `json:"domain"`
+	Permission string `json:"permission"`
+}
+
+type ImportResult struct {
+	DryRun  bool        `json:"dryRun"`
+	Version int         `json:"version"`
+	Diff    *PolicyDiff `json:"diff"`
 }
 
 type Jwt struct {
@@ -46,14 +65,50 @@ type Jwt struct {
 	Properties []*Property `json:"properties"`
 }
 
+type Me struct {
+	User        string   `json:"user"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+}
+
 type ModStaff struct {
 	Newspaper string `json:"newspaper"`
 	Name      string `json:"name"`
 }
 
 type NewJwt struct {
-	User  string   `json:"user"`
-	Roles []string `json:"roles"`
+	User   string   `json:"user"`
+	Roles  []string `json:"roles"`
+	Tenant *string  `json:"tenant"`
+}
+
+type Newspaper struct {
+	Name string `json:"name"`
+}
+
+type Photo struct {
+	UUID      string `json:"uuid"`
+	Newspaper string `json:"newspaper"`
+	Caption   string `json:"caption"`
+	Filename  string `json:"filename"`
+}
+
+type PolicyChange struct {
+	Op          PolicyOp      `json:"op"`
+	Name        string        `json:"name"`
+	Permissions []*string     `json:"permissions"`
+	Parents     []*string     `json:"parents"`
+	Grants      []*GrantInput `json:"grants"`
+	Permission  *string       `json:"permission"`
+	Domain      *string       `json:"domain"`
+}
+
+type PolicyDiff struct {
+	AddedRoles         []string    `json:"addedRoles"`
+	RemovedRoles       []string    `json:"removedRoles"`
+	ChangedRoles       []*RoleDiff `json:"changedRoles"`
+	AddedPermissions   []string    `json:"addedPermissions"`
+	RemovedPermissions []string    `json:"removedPermissions"`
 }
 
 type Property struct {
@@ -61,25 +116,70 @@ type Property struct {
 	Value string `json:"value"`
 }
 
+type Revision struct {
+	Version int    `json:"version"`
+	User    string `json:"user"`
+	Time    string `json:"time"`
+	Change  string `json:"change"`
+}
+
 type Role struct {
 	Name        string    `json:"name"`
 	Permissions []*string `json:"permissions"`
 	Parents     []*string `json:"parents"`
+	Grants      []*Grant  `json:"grants"`
+	Version     int       `json:"version"`
+}
+
+type RoleDiff struct {
+	Name               string   `json:"name"`
+	AddedPermissions   []string `json:"addedPermissions"`
+	RemovedPermissions []string `json:"removedPermissions"`
+	AddedParents       []string `json:"addedParents"`
+	RemovedParents     []string `json:"removedParents"`
+	AddedGrants        []*Grant `json:"addedGrants"`
+	RemovedGrants      []*Grant `json:"removedGrants"`
+}
+
+type RoleGrant struct {
+	Role string   `json:"role"`
+	Path []string `json:"path"`
+}
+
+type Staff struct {
+	Newspaper string `json:"newspaper"`
+	Name      string `json:"name"`
+}
+
+type Story struct {
+	UUID      string `json:"uuid"`
+	Newspaper string `json:"newspaper"`
+	Headline  string `json:"headline"`
+	Story     string `json:"story"`
+}
+
+type StoryFilter struct {
+	Newspapers []string `json:"newspapers"`
+	Headline   *string  `json:"headline"`
 }
 
 type Domain string
 
 const (
 	DomainNewspaper Domain = "newspaper"
+	DomainName      Domain = "name"
+	DomainUUID      Domain = "uuid"
 )
 
 var AllDomain = []Domain{
 	DomainNewspaper,
+	DomainName,
+	DomainUUID,
 }
 
 func (e Domain) IsValid() bool {
 	switch e {
-	case DomainNewspaper:
+	case DomainNewspaper, DomainName, DomainUUID:
 		return true
 	}
 	return false
@@ -106,18 +206,234 @@ func (e Domain) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type DomainLookup string
+
+const (
+	DomainLookupStory DomainLookup = "story"
+	DomainLookupPhoto DomainLookup = "photo"
+)
+
+var AllDomainLookup = []DomainLookup{
+	DomainLookupStory,
+	DomainLookupPhoto,
+}
+
+func (e DomainLookup) IsValid() bool {
+	switch e {
+	case DomainLookupStory, DomainLookupPhoto:
+		return true
+	}
+	return false
+}
+
+func (e DomainLookup) String() string {
+	return string(e)
+}
+
+func (e *DomainLookup) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DomainLookup(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DOMAIN_LOOKUP", str)
+	}
+	return nil
+}
+
+func (e DomainLookup) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GraphFormat string
+
+const (
+	GraphFormatDot     GraphFormat = "DOT"
+	GraphFormatMermaid GraphFormat = "MERMAID"
+	GraphFormatJSON    GraphFormat = "JSON"
+)
+
+var AllGraphFormat = []GraphFormat{
+	GraphFormatDot,
+	GraphFormatMermaid,
+	GraphFormatJSON,
+}
+
+func (e GraphFormat) IsValid() bool {
+	switch e {
+	case GraphFormatDot, GraphFormatMermaid, GraphFormatJSON:
+		return true
+	}
+	return false
+}
+
+func (e GraphFormat) String() string {
+	return string(e)
+}
+
+func (e *GraphFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GraphFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GraphFormat", str)
+	}
+	return nil
+}
+
+func (e GraphFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ImportMode string
+
+const (
+	ImportModeReplace ImportMode = "REPLACE"
+	ImportModeMerge   ImportMode = "MERGE"
+)
+
+var AllImportMode = []ImportMode{
+	ImportModeReplace,
+	ImportModeMerge,
+}
+
+func (e ImportMode) IsValid() bool {
+	switch e {
+	case ImportModeReplace, ImportModeMerge:
+		return true
+	}
+	return false
+}
+
+func (e ImportMode) String() string {
+	return string(e)
+}
+
+func (e *ImportMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ImportMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ImportMode", str)
+	}
+	return nil
+}
+
+func (e ImportMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PolicyFormat string
+
+const (
+	PolicyFormatYaml PolicyFormat = "YAML"
+	PolicyFormatJSON PolicyFormat = "JSON"
+)
+
+var AllPolicyFormat = []PolicyFormat{
+	PolicyFormatYaml,
+	PolicyFormatJSON,
+}
+
+func (e PolicyFormat) IsValid() bool {
+	switch e {
+	case PolicyFormatYaml, PolicyFormatJSON:
+		return true
+	}
+	return false
+}
+
+func (e PolicyFormat) String() string {
+	return string(e)
+}
+
+func (e *PolicyFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyFormat", str)
+	}
+	return nil
+}
+
+func (e PolicyFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PolicyOp string
+
+const (
+	PolicyOpUpsertRole       PolicyOp = "UPSERT_ROLE"
+	PolicyOpDeleteRole       PolicyOp = "DELETE_ROLE"
+	PolicyOpDeletePermission PolicyOp = "DELETE_PERMISSION"
+	PolicyOpRemovePermission PolicyOp = "REMOVE_PERMISSION"
+)
+
+var AllPolicyOp = []PolicyOp{
+	PolicyOpUpsertRole,
+	PolicyOpDeleteRole,
+	PolicyOpDeletePermission,
+	PolicyOpRemovePermission,
+}
+
+func (e PolicyOp) IsValid() bool {
+	switch e {
+	case PolicyOpUpsertRole, PolicyOpDeleteRole, PolicyOpDeletePermission, PolicyOpRemovePermission:
+		return true
+	}
+	return false
+}
+
+func (e PolicyOp) String() string {
+	return string(e)
+}
+
+func (e *PolicyOp) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyOp(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyOp", str)
+	}
+	return nil
+}
+
+func (e PolicyOp) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Rbac string
 
 const (
-	RbacJwtQuery     Rbac = "JWT_QUERY"
-	RbacJwtMutate    Rbac = "JWT_MUTATE"
-	RbacRbacQuery    Rbac = "RBAC_QUERY"
-	RbacRbacMutate   Rbac = "RBAC_MUTATE"
-	RbacModNewspaper Rbac = "MOD_NEWSPAPER"
-	RbacModStaff     Rbac = "MOD_STAFF"
-	RbacModStory     Rbac = "MOD_STORY"
-	RbacModPhoto     Rbac = "MOD_PHOTO"
-	RbacDelMedia     Rbac = "DEL_MEDIA"
+	RbacJwtQuery      Rbac = "JWT_QUERY"
+	RbacJwtMutate     Rbac = "JWT_MUTATE"
+	RbacRbacQuery     Rbac = "RBAC_QUERY"
+	RbacRbacMutate    Rbac = "RBAC_MUTATE"
+	RbacModNewspaper  Rbac = "MOD_NEWSPAPER"
+	RbacModStaff      Rbac = "MOD_STAFF"
+	RbacModStory      Rbac = "MOD_STORY"
+	RbacModPhoto      Rbac = "MOD_PHOTO"
+	RbacDelMedia      Rbac = "DEL_MEDIA"
+	RbacReadNewspaper Rbac = "READ_NEWSPAPER"
+	RbacReadStaff     Rbac = "READ_STAFF"
+	RbacReadStory     Rbac = "READ_STORY"
+	RbacReadPhoto     Rbac = "READ_PHOTO"
+	RbacTenantAdmin   Rbac = "TENANT_ADMIN"
 )
 
 var AllRbac = []Rbac{
@@ -130,11 +446,16 @@ var AllRbac = []Rbac{
 	RbacModStory,
 	RbacModPhoto,
 	RbacDelMedia,
+	RbacReadNewspaper,
+	RbacReadStaff,
+	RbacReadStory,
+	RbacReadPhoto,
+	RbacTenantAdmin,
 }
 
 func (e Rbac) IsValid() bool {
 	switch e {
-	case RbacJwtQuery, RbacJwtMutate, RbacRbacQuery, RbacRbacMutate, RbacModNewspaper, RbacModStaff, RbacModStory, RbacModPhoto, RbacDelMedia:
+	case RbacJwtQuery, RbacJwtMutate, RbacRbacQuery, RbacRbacMutate, RbacModNewspaper, RbacModStaff, RbacModStory, RbacModPhoto, RbacDelMedia, RbacReadNewspaper, RbacReadStaff, RbacReadStory, RbacReadPhoto, RbacTenantAdmin:
 		return true
 	}
 	return false
@@ -160,3 +481,44 @@ func (e *Rbac) UnmarshalGQL(v interface{}) error {
 func (e Rbac) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type Tenant string
+
+const (
+	TenantClaim  Tenant = "claim"
+	TenantHeader Tenant = "header"
+)
+
+var AllTenant = []Tenant{
+	TenantClaim,
+	TenantHeader,
+}
+
+func (e Tenant) IsValid() bool {
+	switch e {
+	case TenantClaim, TenantHeader:
+		return true
+	}
+	return false
+}
+
+func (e Tenant) String() string {
+	return string(e)
+}
+
+func (e *Tenant) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Tenant(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TENANT", str)
+	}
+	return nil
+}
+
+func (e Tenant) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	domain "github.com/JeremyMarshall/gqlgen-jwt/domain/types"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+)
+
+// checkDomain rejects names whose provisioned roles would grant outside the domain
+// AnyDomain is every domain and the placeholder is expanded again by later templates
+// A name can be below another newspaper, eg acme/the-bugle, but each part of it must be named
+func checkDomain(domain string) error {
+	switch {
+	case domain == "":
+		return fmt.Errorf("Newspaper name can't be empty")
+	case domain == types.AnyDomain:
+		return fmt.Errorf("Newspaper name %s is reserved", domain)
+	case strings.Contains(domain, types.DomainPlaceholder):
+		return fmt.Errorf("Newspaper name %s can't contain %s", domain, types.DomainPlaceholder)
+	}
+	for _, part := range strings.Split(domain, types.DomainSeparator) {
+		if part == "" {
+			return fmt.Errorf("Newspaper name %s has an empty part", domain)
+		}
+	}
+	return nil
+}
+
+// checkParent lets a newspaper below another be added only by those who can MOD_NEWSPAPER in the one above,
+// which must exist, as that newspaper's roles are then granted in it
+func checkParent(ctx context.Context, rbac types.Rbac, store domain.Store, name string) error {
+	path := types.DomainPath(name)
+	if len(path) < 2 {
+		return nil
+	}
+	parent := path[1]
+
+	if _, err := store.Newspaper(parent); err != nil {
+		return err
+	}
+	if !CheckDomain(ctx, rbac, parent, model.RbacModNewspaper.String()) {
+		return fmt.Errorf("Access denied")
+	}
+	return nil
+}
+
+// provision creates the roles for a new domain from the policy templates
+// It is a single batch so either every role is created or none are
+func provision(ctx context.Context, rbac types.Rbac, domain string) error {
+	if err := checkDomain(domain); err != nil {
+		return err
+	}
+
+	templates, err := rbac.Templates()
+	if err != nil {
+		return err
+	}
+
+	changes := types.ProvisionChanges(templates, domain)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err = rbac.ApplyChanges(changes, newMutation(ctx, nil))
+	return err
+}
+
+// deprovision removes the roles provision created for a domain, and the permissions named for it
+// Only template permissions with the placeholder are the domain's own, and any another role still has are kept
+// Roles or permissions already removed by hand are skipped
+func deprovision(ctx context.Context, rbac types.Rbac, domain string) error {
+	templates, err := rbac.Templates()
+	if err != nil {
+		return err
+	}
+
+	roles, err := rbac.GetRoles(nil)
+	if err != nil {
+		return err
+	}
+
+	permissions, err := rbac.GetPermissions(nil)
+	if err != nil {
+		return err
+	}
+	declared := make(map[string]bool, len(permissions))
+	for _, p := range permissions {
+		declared[p] = true
+	}
+
+	changes := make([]types.Change, 0)
+	deleted := make(map[string]bool)
+	for _, c := range types.ProvisionChanges(templates, domain) {
+		if _, ok := roles[c.Name]; ok {
+			deleted[c.Name] = true
+			changes = append(changes, types.Change{Op: types.ChangeDeleteRole, Name: c.Name})
+		}
+	}
+
+	kept, err := stillGranted(rbac, roles, deleted)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	removed := make(map[string]bool)
+	for _, name := range names {
+		for _, t := range templates[name].Permissions {
+			if !strings.Contains(t, types.DomainPlaceholder) {
+				continue
+			}
+			p := types.ExpandTemplate(t, domain)
+			if declared[p] && !removed[p] && !kept[p] {
+				removed[p] = true
+				changes = append(changes, types.Change{Op: types.ChangeRemovePermission, Permission: p})
+			}
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err = rbac.ApplyChanges(changes, newMutation(ctx, nil))
+	return err
+}
+
+// stillGranted is every permission a role other than those deleted has, in any domain, or a tenant's role has
+func stillGranted(rbac types.Rbac, roles map[string]types.Role, deleted map[string]bool) (map[string]bool, error) {
+	ret := make(map[string]bool)
+	grant := func(role types.Role) {
+		for _, p := range role.Permissions {
+			ret[p] = true
+		}
+		for _, g := range role.Grants() {
+			ret[g.Permission] = true
+		}
+	}
+
+	for name, role := range roles {
+		if !deleted[name] {
+			grant(role)
+		}
+	}
+
+	tenants, err := rbac.Tenants()
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tenants {
+		tenantRoles, err := rbac.GetTenantRoles(t, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range tenantRoles {
+			grant(role)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+)
+
+// Requirement is the permissions an rbac directive asks for
+// AllOf must all be granted and, if there are any, one of AnyOf
+type Requirement struct {
+	AllOf []string
+	AnyOf []string
+}
+
+// NewRequirement is the requirement of a directive's rbac, anyOf and allOf arguments, rbac being one of allOf
+func NewRequirement(rbac *model.Rbac, anyOf []model.Rbac, allOf []model.Rbac) Requirement {
+	ret := Requirement{
+		AllOf: make([]string, 0, len(allOf)+1),
+		AnyOf: make([]string, 0, len(anyOf)),
+	}
+	if rbac != nil {
+		ret.AllOf = append(ret.AllOf, rbac.String())
+	}
+	for _, r := range allOf {
+		ret.AllOf = append(ret.AllOf, r.String())
+	}
+	for _, r := range anyOf {
+		ret.AnyOf = append(ret.AnyOf, r.String())
+	}
+	return ret
+}
+
+// Met asks check about each permission, stopping as soon as the answer is known
+// A requirement with no permissions is never met so a directive missing its arguments denies
+func (r Requirement) Met(check func(permission string) bool) bool {
+	if len(r.AllOf) == 0 && len(r.AnyOf) == 0 {
+		return false
+	}
+	for _, p := range r.AllOf {
+		if !check(p) {
+			return false
+		}
+	}
+	if len(r.AnyOf) == 0 {
+		return true
+	}
+	for _, p := range r.AnyOf {
+		if check(p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package graph_test
+
+import (
+	"github.com/JeremyMarshall/gqlgen-jwt/graph"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Requirement", func() {
+	var (
+		asked []string
+	)
+	grants := func(granted ...string) func(string) bool {
+		asked = make([]string, 0)
+		return func(permission string) bool {
+			asked = append(asked, permission)
+			for _, g := range granted {
+				if g == permission {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	modStory, delMedia, readStory := model.RbacModStory, model.RbacDelMedia, model.RbacReadStory
+
+	It("should treat rbac as one of allOf", func() {
+		required := graph.NewRequirement(&readStory, nil, []model.Rbac{modStory})
+		Expect(required.AllOf).To(Equal([]string{"READ_STORY", "MOD_STORY"}))
+		Expect(required.Met(grants("READ_STORY", "MOD_STORY"))).To(BeTrue())
+		Expect(required.Met(grants("READ_STORY"))).To(BeFalse())
+	})
+	It("should need any one of anyOf", func() {
+		required := graph.NewRequirement(nil, []model.Rbac{modStory, delMedia}, nil)
+		Expect(required.Met(grants("DEL_MEDIA"))).To(BeTrue())
+		Expect(required.Met(grants("READ_STORY"))).To(BeFalse())
+	})
+	It("should need both allOf and anyOf", func() {
+		required := graph.NewRequirement(&readStory, []model.Rbac{modStory, delMedia}, nil)
+		Expect(required.Met(grants("READ_STORY", "MOD_STORY"))).To(BeTrue())
+		Expect(required.Met(grants("MOD_STORY"))).To(BeFalse())
+		Expect(asked).To(Equal([]string{"READ_STORY"}))
+	})
+	It("should stop once anyOf is met", func() {
+		required := graph.NewRequirement(nil, []model.Rbac{modStory, delMedia}, nil)
+		Expect(required.Met(grants("MOD_STORY"))).To(BeTrue())
+		Expect(asked).To(Equal([]string{"MOD_STORY"}))
+	})
+	It("should never be met without any permissions", func() {
+		Expect(graph.NewRequirement(nil, nil, nil).Met(grants("READ_STORY"))).To(BeFalse())
+	})
+})
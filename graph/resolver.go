@@ -1,6 +1,10 @@
 package graph
 
-import "github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+import (
+	domain "github.com/JeremyMarshall/gqlgen-jwt/domain/types"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/persist"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+)
 
 // This file will not be regenerated automatically.
 //
@@ -9,5 +13,6 @@ import "github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
 type Resolver struct {
 	Rbac      types.Rbac
 	JwtSecret string
-	Serialize string
+	Serialize *persist.File
+	Store     domain.Store
 }
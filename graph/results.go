@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ObjectDomains finds the domains in path of a resolved object, eg a story's newspaper
+// The object is read as it is returned so path uses the schema's field names, see Domains
+func ObjectDomains(ctx context.Context, obj interface{}, path string, lookup DomainLookup) ([]string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var fields interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return Domains(ctx, fields, path, lookup)
+}
+
+// Filter is the items of list, a slice, which keep is true for
+// It is a slice of the same type so it can stand in for the resolver's result
+func Filter(list interface{}, keep func(item interface{}) bool) (interface{}, error) {
+	if list == nil {
+		return nil, nil
+	}
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("Can't filter %T", list)
+	}
+
+	ret := reflect.MakeSlice(v.Type(), 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		if keep(v.Index(i).Interface()) {
+			ret = reflect.Append(ret, v.Index(i))
+		}
+	}
+	return ret.Interface(), nil
+}
+
+// Masked is what a masked field resolves to, the replacement as the field's type or nil without one
+func Masked(ctx context.Context, replacement *string) interface{} {
+	if replacement == nil {
+		return nil
+	}
+	// the generated code wants a *string for a nullable field
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Field != nil && fc.Field.Definition != nil && !fc.Field.Definition.Type.NonNull {
+		return replacement
+	}
+	return *replacement
+}
@@ -0,0 +1,45 @@
+package graph_test
+
+import (
+	"context"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/graph"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Results", func() {
+	Context("Object domains", func() {
+		It("should use the schema's field names", func() {
+			domains, err := graph.ObjectDomains(context.Background(), &model.Story{UUID: "a", Newspaper: "times"}, "newspaper", nil)
+			Expect(err).To(BeNil())
+			Expect(domains).To(Equal([]string{"times"}))
+		})
+		It("should fail for a field the object doesn't have", func() {
+			_, err := graph.ObjectDomains(context.Background(), &model.Story{}, "paper", nil)
+			Expect(err).To(MatchError("Domain field paper not found"))
+		})
+	})
+	Context("Filter", func() {
+		It("should keep the type of the list", func() {
+			list := []*model.Newspaper{{Name: "times"}, {Name: "mail"}}
+			res, err := graph.Filter(list, func(item interface{}) bool {
+				return item.(*model.Newspaper).Name == "mail"
+			})
+			Expect(err).To(BeNil())
+			Expect(res).To(Equal([]*model.Newspaper{{Name: "mail"}}))
+		})
+		It("should only filter lists", func() {
+			_, err := graph.Filter(&model.Newspaper{}, func(item interface{}) bool { return true })
+			Expect(err).To(MatchError("Can't filter *model.Newspaper"))
+		})
+	})
+	Context("Masked", func() {
+		It("should be the replacement or nil", func() {
+			replacement := "redacted"
+			Expect(graph.Masked(context.Background(), &replacement)).To(Equal("redacted"))
+			Expect(graph.Masked(context.Background(), nil)).To(BeNil())
+		})
+	})
+})
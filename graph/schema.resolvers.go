@@ -6,18 +6,21 @@ package graph
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
+	"strings"
 	"time"
 
+	domain "github.com/JeremyMarshall/gqlgen-jwt/domain/types"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph/generated"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
 func (r *mutationResolver) CreateJwt(ctx context.Context, input model.NewJwt) (string, error) {
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	claims := jwt.MapClaims{
 		"user":  input.User,
 		"roles": input.Roles,
 
@@ -34,7 +37,11 @@ func (r *mutationResolver) CreateJwt(ctx context.Context, input model.NewJwt) (s
 		// nbf	Not Before		Identifies the time on which the JWT will start to be accepted for processing. The value must be a NumericDate.
 		// iat	Issued at		Identifies the time at which the JWT was issued. The value must be a NumericDate.
 		// jti	JWT ID			Case sensitive unique identifier of the token even among different issuers.
-	})
+	}
+	if input.Tenant != nil {
+		claims[TenantClaim] = *input.Tenant
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	// Sign and get the complete encoded token as a string using the secret
 	tokenString, err := token.SignedString([]byte(r.JwtSecret))
@@ -45,7 +52,7 @@ func (r *mutationResolver) CreateJwt(ctx context.Context, input model.NewJwt) (s
 func (r *mutationResolver) UpsertRole(ctx context.Context, input model.AddRole) (*model.Role, error) {
 	// If the role exists, update the permissions
 	// If the role doesn't exist create it and add the permissions
-	role, err := r.Rbac.UpsertRole(&input.Name, input.Permissions, input.Parents)
+	role, err := r.Rbac.UpsertRole(&input.Name, input.Permissions, input.Parents, newMutation(ctx, input.ExpectedVersion))
 	if err != nil {
 		return nil, err
 	}
@@ -53,53 +60,163 @@ func (r *mutationResolver) UpsertRole(ctx context.Context, input model.AddRole)
 }
 
 func (r *mutationResolver) DeleteRole(ctx context.Context, input model.DeleteRole) (bool, error) {
-	return r.Rbac.DeleteRole(&input.Name)
+	return r.Rbac.DeleteRole(&input.Name, newMutation(ctx, input.ExpectedVersion))
 }
 
 func (r *mutationResolver) DeletePermission(ctx context.Context, input model.DeletePermission) (bool, error) {
-	return r.Rbac.DeletePermission(&input.Name, &input.Permission)
+	return r.Rbac.DeletePermission(&input.Name, &input.Permission, newMutation(ctx, input.ExpectedVersion))
 }
 
-func (r *mutationResolver) Save(ctx context.Context) (bool, error) {
-	f, err := os.Create(r.Serialize)
-	defer f.Close()
+func (r *mutationResolver) ApplyPolicyChanges(ctx context.Context, changes []*model.PolicyChange, expectedVersion *int) ([]*model.Role, error) {
+	batch := make([]types.Change, 0)
+	for _, c := range changes {
+		batch = append(batch, convertChange(c))
+	}
+
+	roles, err := r.Rbac.ApplyChanges(batch, newMutation(ctx, expectedVersion))
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	err = r.Rbac.Save(f)
+
+	return convertRoles(roles), nil
+}
+
+func (r *mutationResolver) Save(ctx context.Context, expectedVersion *int) (bool, error) {
+	m := newMutation(ctx, expectedVersion)
+	err := r.Serialize.Write(func(writer io.Writer) error {
+		return r.Rbac.Save(writer, m)
+	})
 	return err == nil, err
 }
 
+func (r *mutationResolver) Restore(ctx context.Context, backup string, expectedVersion *int) (bool, error) {
+	m := newMutation(ctx, expectedVersion)
+	// the backup only replaces the live file if it loads, and only becomes live once it is written
+	err := r.Serialize.Restore(backup, func(reader io.Reader, write func(fn func(writer io.Writer) error) error) error {
+		return r.Rbac.Restore(reader, write, m)
+	})
+	return err == nil, err
+}
+
+func (r *mutationResolver) RollbackPolicy(ctx context.Context, version int, expectedVersion *int) (bool, error) {
+	err := r.Rbac.Rollback(version, newMutation(ctx, expectedVersion))
+	return err == nil, err
+}
+
+func (r *mutationResolver) ImportPolicy(ctx context.Context, document string, mode model.ImportMode, dryRun *bool, expectedVersion *int) (*model.ImportResult, error) {
+	result, err := r.Rbac.Import(strings.NewReader(document), types.ImportMode(mode), dryRun != nil && *dryRun, newMutation(ctx, expectedVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ImportResult{
+		DryRun:  result.DryRun,
+		Version: result.Version,
+		Diff:    convertPolicyDiff(result.Diff),
+	}, nil
+}
+
 func (r *mutationResolver) AddNewspaper(ctx context.Context, name string) (string, error) {
-	return "Add Newspaper", nil
+	if err := checkDomain(name); err != nil {
+		return "", err
+	}
+	if err := checkParent(ctx, r.Rbac, r.Store, name); err != nil {
+		return "", err
+	}
+	paper, err := r.Store.AddNewspaper(name)
+	if err != nil {
+		return "", err
+	}
+	// a newspaper without its roles can't be used, so don't keep it
+	if err := provision(ctx, r.Rbac, name); err != nil {
+		if _, derr := r.Store.DeleteNewspaper(name); derr != nil {
+			return "", fmt.Errorf("Newspaper %s roles not created, %v, and not removed, %v", name, err, derr)
+		}
+		return "", fmt.Errorf("Newspaper %s roles not created, %w", name, err)
+	}
+	return paper.Name, nil
 }
 
 func (r *mutationResolver) DeleteNewspaper(ctx context.Context, name string) (bool, error) {
-	return true, nil
+	ok, err := r.Store.DeleteNewspaper(name)
+	if err != nil {
+		return ok, err
+	}
+	if err := deprovision(ctx, r.Rbac, name); err != nil {
+		return false, fmt.Errorf("Newspaper %s deleted but roles not removed, %w", name, err)
+	}
+	return ok, nil
 }
 
 func (r *mutationResolver) AddStaff(ctx context.Context, input model.ModStaff) (string, error) {
-	return "Add Staff", nil
+	staff, err := r.Store.AddStaff(input.Newspaper, input.Name)
+	return staff.Name, err
 }
 
 func (r *mutationResolver) AddStory(ctx context.Context, input model.AddStory) (string, error) {
-	return "Add Story", nil
+	story, err := r.Store.AddStory(input.Newspaper, input.Headline, input.Story)
+	return story.UUID, err
 }
 
 func (r *mutationResolver) AddPhoto(ctx context.Context, input model.AddPhoto) (string, error) {
-	return "Add Photo", nil
+	photo, err := r.Store.AddPhoto(input.Newspaper, input.Caption, input.Filename)
+	return photo.UUID, err
 }
 
 func (r *mutationResolver) DeleteStaff(ctx context.Context, input model.ModStaff) (bool, error) {
-	return true, nil
+	return r.Store.DeleteStaff(input.Newspaper, input.Name)
 }
 
 func (r *mutationResolver) DeleteStory(ctx context.Context, input model.DeleteMedia) (bool, error) {
-	return true, nil
+	return r.Store.DeleteStory(input.Newspaper, input.UUID)
 }
 
 func (r *mutationResolver) DeletePhoto(ctx context.Context, input model.DeleteMedia) (bool, error) {
-	return true, nil
+	return r.Store.DeletePhoto(input.Newspaper, input.UUID)
+}
+
+func (r *mutationResolver) DeleteStories(ctx context.Context, uuids []string) (int, error) {
+	deleted := 0
+	for _, id := range uuids {
+		story, err := r.Store.Story(id)
+		if err != nil {
+			return deleted, err
+		}
+		if _, err := r.Store.DeleteStory(story.Newspaper, id); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (r *mutationResolver) AddTenantStory(ctx context.Context, headline string, story string) (string, error) {
+	newspaper, err := CheckedDomain(ctx)
+	if err != nil {
+		return "", err
+	}
+	s, err := r.Store.AddStory(newspaper, headline, story)
+	return s.UUID, err
+}
+
+func (r *mutationResolver) UpsertTenantRole(ctx context.Context, input model.AddRole) (*model.Role, error) {
+	tenant, err := CheckedDomain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, err := r.Rbac.UpsertTenantRole(tenant, &input.Name, input.Permissions, input.Parents, newMutation(ctx, input.ExpectedVersion))
+	if err != nil {
+		return nil, err
+	}
+	return convertRole(input.Name, role), nil
+}
+
+func (r *mutationResolver) DeleteTenantRole(ctx context.Context, input model.DeleteRole) (bool, error) {
+	tenant, err := CheckedDomain(ctx)
+	if err != nil {
+		return false, err
+	}
+	return r.Rbac.DeleteTenantRole(tenant, &input.Name, newMutation(ctx, input.ExpectedVersion))
 }
 
 func (r *queryResolver) Jwt(ctx context.Context, token string) (*model.Jwt, error) {
@@ -169,6 +286,220 @@ func (r *queryResolver) Role(ctx context.Context, name *string) ([]*model.Role,
 	return ret, nil
 }
 
+func (r *queryResolver) Backups(ctx context.Context) ([]string, error) {
+	return r.Serialize.List()
+}
+
+func (r *queryResolver) PolicyHistory(ctx context.Context) ([]*model.Revision, error) {
+	history, err := r.Rbac.History()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*model.Revision, 0)
+	for _, v := range history {
+		ret = append(ret, convertRevision(v))
+	}
+	return ret, nil
+}
+
+func (r *queryResolver) PolicyDiff(ctx context.Context, from int, to int) (*model.PolicyDiff, error) {
+	diff, err := r.Rbac.Diff(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return convertPolicyDiff(diff), nil
+}
+
+func (r *queryResolver) ExportPolicy(ctx context.Context, format model.PolicyFormat) (string, error) {
+	var b strings.Builder
+	if err := r.Rbac.Export(&b, types.Format(format)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (r *queryResolver) EffectivePermissions(ctx context.Context, roles []string, domain *string) ([]string, error) {
+	// without roles it is the current user's
+	if roles == nil {
+		roles = GetCurrentUser(ctx).Roles
+	}
+	return r.Rbac.EffectivePermissions(roles, domain)
+}
+
+func (r *queryResolver) RolesGranting(ctx context.Context, permission string, domain *string) ([]*model.RoleGrant, error) {
+	grants, err := r.Rbac.RolesGranting(permission, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*model.RoleGrant, 0, len(grants))
+	for _, g := range grants {
+		ret = append(ret, &model.RoleGrant{
+			Role: g.Role,
+			Path: g.Path,
+		})
+	}
+	return ret, nil
+}
+
+func (r *queryResolver) RoleGraph(ctx context.Context, format model.GraphFormat) (string, error) {
+	var b strings.Builder
+	if err := r.Rbac.RoleGraph(&b, types.GraphFormat(format)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (r *queryResolver) Me(ctx context.Context, domain *string) (*model.Me, error) {
+	user := GetCurrentUser(ctx)
+	roles := user.Roles
+	if roles == nil {
+		roles = make([]string, 0)
+	}
+
+	permissions, err := r.Rbac.EffectivePermissions(roles, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Me{
+		User:        user.User,
+		Roles:       roles,
+		Permissions: permissions,
+	}, nil
+}
+
+func (r *queryResolver) Newspapers(ctx context.Context) ([]*model.Newspaper, error) {
+	papers, err := r.Store.Newspapers()
+	if err != nil {
+		return nil, err
+	}
+
+	// FilterRbacDomain drops those the user can't READ_NEWSPAPER
+	ret := make([]*model.Newspaper, 0, len(papers))
+	for _, p := range papers {
+		ret = append(ret, &model.Newspaper{Name: p.Name})
+	}
+	return ret, nil
+}
+
+func (r *queryResolver) Newspaper(ctx context.Context, name string) (*model.Newspaper, error) {
+	paper, err := r.Store.Newspaper(name)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Newspaper{Name: paper.Name}, nil
+}
+
+func (r *queryResolver) Staff(ctx context.Context, newspaper string) ([]*model.Staff, error) {
+	staff, err := r.Store.Staff(newspaper)
+	if err != nil {
+		return nil, err
+	}
+	return convertStaff(staff), nil
+}
+
+func (r *queryResolver) Stories(ctx context.Context, newspaper string) ([]*model.Story, error) {
+	stories, err := r.Store.Stories(newspaper)
+	if err != nil {
+		return nil, err
+	}
+	return convertStories(stories), nil
+}
+
+func (r *queryResolver) Photos(ctx context.Context, newspaper string) ([]*model.Photo, error) {
+	photos, err := r.Store.Photos(newspaper)
+	if err != nil {
+		return nil, err
+	}
+	return convertPhotos(photos), nil
+}
+
+func (r *queryResolver) Story(ctx context.Context, uuid string) (*model.Story, error) {
+	story, err := r.Store.Story(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return convertStories([]domain.Story{story})[0], nil
+}
+
+func (r *queryResolver) Photo(ctx context.Context, uuid string) (*model.Photo, error) {
+	photo, err := r.Store.Photo(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return convertPhotos([]domain.Photo{photo})[0], nil
+}
+
+func (r *queryResolver) SearchStories(ctx context.Context, filter model.StoryFilter) ([]*model.Story, error) {
+	headline := ""
+	if filter.Headline != nil {
+		headline = strings.ToLower(*filter.Headline)
+	}
+
+	ret := make([]domain.Story, 0)
+	seen := make(map[string]bool)
+	for _, newspaper := range filter.Newspapers {
+		if seen[newspaper] {
+			continue
+		}
+		seen[newspaper] = true
+
+		stories, err := r.Store.Stories(newspaper)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stories {
+			if strings.Contains(strings.ToLower(s.Headline), headline) {
+				ret = append(ret, s)
+			}
+		}
+	}
+	return convertStories(ret), nil
+}
+
+func (r *queryResolver) TenantStories(ctx context.Context) ([]*model.Story, error) {
+	newspaper, err := CheckedDomain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.Stories(ctx, newspaper)
+}
+
+func (r *queryResolver) TenantPhotos(ctx context.Context) ([]*model.Photo, error) {
+	newspaper, err := CheckedDomain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.Photos(ctx, newspaper)
+}
+
+func (r *queryResolver) TenantRoles(ctx context.Context, name *string) ([]*model.Role, error) {
+	tenant, err := CheckedDomain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := r.Rbac.GetTenantRoles(tenant, name)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*model.Role, 0)
+	for k, v := range roles {
+		ret = append(ret, convertRole(k, v))
+	}
+	return ret, nil
+}
+
+func (r *queryResolver) TenantPermissions(ctx context.Context) ([]string, error) {
+	tenant, err := CheckedDomain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.Rbac.GetTenantPermissions(tenant)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -2,9 +2,21 @@ package graph_test
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/memory"
+	domain "github.com/JeremyMarshall/gqlgen-jwt/domain/types"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/dummy"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/gorbac"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/persist"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	jwt "github.com/dgrijalva/jwt-go"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -61,6 +73,78 @@ var _ = Describe("Schema.Resolvers", func() {
 			})
 		})
 
+		Context("Upsert with the current version", func() {
+			It("should succeed", func() {
+				v := dummy.Version
+				role, err := resolver.Mutation().UpsertRole(context.Background(), model.AddRole{Name: "role1", ExpectedVersion: &v})
+
+				Expect(err).To(BeNil())
+				Expect(role.Version).To(Equal(dummy.Version))
+			})
+		})
+
+		Context("Mutate with a stale version", func() {
+			It("should conflict", func() {
+				v := dummy.Version - 1
+				_, err := resolver.Mutation().UpsertRole(context.Background(), model.AddRole{Name: "role1", ExpectedVersion: &v})
+				Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+				_, err = resolver.Mutation().DeleteRole(context.Background(), model.DeleteRole{Name: "role1", ExpectedVersion: &v})
+				Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+				_, err = resolver.Mutation().DeletePermission(context.Background(), model.DeletePermission{Name: "role1", Permission: "perm1", ExpectedVersion: &v})
+				Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+			})
+		})
+
+		Context("Can apply changes", func() {
+			It("should succeed", func() {
+				p := "perm1"
+				roles, err := resolver.Mutation().ApplyPolicyChanges(context.Background(), []*model.PolicyChange{
+					{Op: model.PolicyOpUpsertRole, Name: "role2", Permissions: []*string{&p, nil}},
+					{Op: model.PolicyOpUpsertRole, Name: "role1"},
+					{Op: model.PolicyOpDeleteRole, Name: "role3"},
+				}, nil)
+
+				Expect(err).To(BeNil())
+				Expect(roles).To(HaveLen(2))
+				Expect(roles[0].Name).To(Equal("role1"))
+				Expect(roles[1].Name).To(Equal("role2"))
+				Expect(roles[1].Permissions).To(Equal([]*string{&p}))
+			})
+		})
+
+		Context("Can grant within a domain", func() {
+			It("should succeed", func() {
+				roles, err := resolver.Mutation().ApplyPolicyChanges(context.Background(), []*model.PolicyChange{
+					{Op: model.PolicyOpUpsertRole, Name: "role1", Grants: []*model.GrantInput{
+						{Domain: "the-bugle", Permission: "mod-story"},
+						nil,
+						{Domain: "*", Permission: "read-story"},
+					}},
+				}, nil)
+
+				Expect(err).To(BeNil())
+				Expect(roles).To(HaveLen(1))
+				Expect(roles[0].Grants).To(Equal([]*model.Grant{
+					{Domain: "*", Permission: "read-story"},
+					{Domain: "the-bugle", Permission: "mod-story"},
+				}))
+			})
+		})
+
+		Context("Cannot apply invalid changes", func() {
+			It("should fail", func() {
+				_, err := resolver.Mutation().ApplyPolicyChanges(context.Background(), []*model.PolicyChange{
+					{Op: model.PolicyOpUpsertRole, Name: "role1"},
+					{Op: model.PolicyOpDeleteRole, Name: "error"},
+				}, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Context("Can delete role", func() {
 			It("should succeed", func() {
 				ok, err := resolver.Mutation().DeleteRole(context.Background(), model.DeleteRole{})
@@ -155,6 +239,7 @@ var _ = Describe("Schema.Resolvers", func() {
 
 				Expect(err).To(BeNil())
 				Expect(len(roles)).To(Equal(1))
+				Expect(roles[0].Version).To(Equal(dummy.Version))
 			})
 		})
 		Context("Can't get invalid role", func() {
@@ -166,13 +251,457 @@ var _ = Describe("Schema.Resolvers", func() {
 			})
 		})
 	})
+	Describe("Effective permissions", func() {
+		Context("Can get the permissions of roles", func() {
+			It("should succeed", func() {
+				perms, err := resolver.Query().EffectivePermissions(context.Background(), []string{"role1"}, nil)
+
+				Expect(err).To(BeNil())
+				Expect(perms).To(HaveLen(2))
+			})
+		})
+		Context("Defaults to the current user's roles", func() {
+			It("should succeed", func() {
+				perms, err := resolver.Query().EffectivePermissions(userContext("alice"), nil, nil)
+
+				Expect(err).To(BeNil())
+				Expect(perms).To(BeEmpty())
+			})
+		})
+		Context("Can't get the permissions of an invalid domain", func() {
+			It("should fail", func() {
+				domain := "error"
+				_, err := resolver.Query().EffectivePermissions(context.Background(), []string{"role1"}, &domain)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("Can get me", func() {
+			It("should succeed", func() {
+				me, err := resolver.Query().Me(userContext("alice", "role1"), nil)
+
+				Expect(err).To(BeNil())
+				Expect(me.User).To(Equal("alice"))
+				Expect(me.Roles).To(Equal([]string{"role1"}))
+				Expect(me.Permissions).To(HaveLen(2))
+			})
+		})
+		Context("Can get me without a token", func() {
+			It("should succeed", func() {
+				me, err := resolver.Query().Me(context.Background(), nil)
+
+				Expect(err).To(BeNil())
+				Expect(me.Roles).To(BeEmpty())
+				Expect(me.Permissions).To(BeEmpty())
+			})
+		})
+	})
+	Describe("Roles granting", func() {
+		Context("Can find roles granting a permission", func() {
+			It("should succeed", func() {
+				grants, err := resolver.Query().RolesGranting(context.Background(), "RBAC_QUERY", nil)
+
+				Expect(err).To(BeNil())
+				Expect(grants).To(HaveLen(1))
+				Expect(grants[0].Path).To(Equal([]string{grants[0].Role}))
+			})
+		})
+		Context("Can't find roles granting an invalid permission", func() {
+			It("should fail", func() {
+				_, err := resolver.Query().RolesGranting(context.Background(), "error", nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	Describe("Role graph", func() {
+		Context("Can render the role graph", func() {
+			It("should succeed", func() {
+				graph, err := resolver.Query().RoleGraph(context.Background(), model.GraphFormatMermaid)
+
+				Expect(err).To(BeNil())
+				Expect(graph).To(ContainSubstring("role1"))
+			})
+		})
+	})
+	Describe("History", func() {
+		Context("Can get history", func() {
+			It("should succeed", func() {
+				history, err := resolver.Query().PolicyHistory(context.Background())
+
+				Expect(err).To(BeNil())
+				Expect(history).To(HaveLen(1))
+				Expect(history[0].Version).To(Equal(dummy.Version))
+			})
+		})
+		Context("Can diff", func() {
+			It("should succeed", func() {
+				diff, err := resolver.Query().PolicyDiff(context.Background(), dummy.Version, dummy.Version)
+
+				Expect(err).To(BeNil())
+				Expect(diff.ChangedRoles).To(BeEmpty())
+			})
+		})
+		Context("Can't diff unknown versions", func() {
+			It("should fail", func() {
+				_, err := resolver.Query().PolicyDiff(context.Background(), 0, dummy.Version)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("Can rollback", func() {
+			It("should succeed", func() {
+				ok, err := resolver.Mutation().RollbackPolicy(context.Background(), dummy.Version, nil)
+
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+			})
+		})
+		Context("Can't rollback unknown version", func() {
+			It("should fail", func() {
+				_, err := resolver.Mutation().RollbackPolicy(context.Background(), 0, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	Describe("Import and export", func() {
+		Context("Can export", func() {
+			It("should succeed", func() {
+				document, err := resolver.Query().ExportPolicy(context.Background(), model.PolicyFormatJSON)
+
+				Expect(err).To(BeNil())
+				Expect(document).NotTo(BeEmpty())
+			})
+		})
+		Context("Can import", func() {
+			It("should succeed", func() {
+				dryRun := true
+				result, err := resolver.Mutation().ImportPolicy(context.Background(), "roles: {}", model.ImportModeMerge, &dryRun, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.DryRun).To(BeTrue())
+				Expect(result.Version).To(Equal(dummy.Version))
+			})
+		})
+		Context("Can't import an invalid document", func() {
+			It("should fail", func() {
+				_, err := resolver.Mutation().ImportPolicy(context.Background(), "error", model.ImportModeReplace, nil, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	Describe("Persistence", func() {
+		var (
+			dir  string
+			path string
+		)
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "graph")
+			Expect(err).To(BeNil())
+
+			path = filepath.Join(dir, "all.yaml")
+			Expect(ioutil.WriteFile(path, []byte("error"), 0644)).To(BeNil())
+
+			resolver.Serialize = persist.NewFile(path, 2)
+		})
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		Context("Can save", func() {
+			It("should succeed", func() {
+				ok, err := resolver.Mutation().Save(context.Background(), nil)
+
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+
+				backups, err := resolver.Query().Backups(context.Background())
+				Expect(err).To(BeNil())
+				Expect(backups).To(HaveLen(1))
+			})
+		})
+		Context("Can't restore a backup which doesn't load", func() {
+			It("should fail", func() {
+				_, err := resolver.Mutation().Save(context.Background(), nil)
+				Expect(err).To(BeNil())
+
+				backups, err := resolver.Query().Backups(context.Background())
+				Expect(err).To(BeNil())
+
+				_, err = resolver.Mutation().Restore(context.Background(), backups[0], nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("Can restore a backup", func() {
+			It("should succeed", func() {
+				Expect(ioutil.WriteFile(path, []byte("roles: {}"), 0644)).To(BeNil())
+				_, err := resolver.Mutation().Save(context.Background(), nil)
+				Expect(err).To(BeNil())
+
+				backups, err := resolver.Query().Backups(context.Background())
+				Expect(err).To(BeNil())
+
+				ok, err := resolver.Mutation().Restore(context.Background(), backups[0], nil)
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+
+				data, err := ioutil.ReadFile(path)
+				Expect(err).To(BeNil())
+				Expect(string(data)).To(Equal("roles: {}"))
+			})
+		})
+		Context("Save or restore with a stale version", func() {
+			It("should conflict and write nothing", func() {
+				Expect(ioutil.WriteFile(path, []byte("roles: {}"), 0644)).To(BeNil())
+				_, err := resolver.Mutation().Save(context.Background(), nil)
+				Expect(err).To(BeNil())
+				backups, err := resolver.Query().Backups(context.Background())
+				Expect(err).To(BeNil())
+
+				v := dummy.Version + 1
+				_, err = resolver.Mutation().Save(context.Background(), &v)
+				Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+				_, err = resolver.Mutation().Restore(context.Background(), backups[0], &v)
+				Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+				after, err := resolver.Query().Backups(context.Background())
+				Expect(err).To(BeNil())
+				Expect(after).To(Equal(backups))
+			})
+		})
+		Context("Can't restore unknown backup", func() {
+			It("should fail", func() {
+				_, err := resolver.Mutation().Restore(context.Background(), "invalid", nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	Describe("Provisioning", func() {
+		var (
+			rbac *gorbac.Rbac
+		)
+		BeforeEach(func() {
+			var err error
+			rbac, err = gorbac.NewRbac(strings.NewReader(`
+permissions:
+- mod-newspaper
+- mod-story
+- read-story
+roles:
+ newspaper-admin:
+  permissions:
+  - mod-newspaper
+templates:
+ "{domain}-editor":
+  domains:
+   "{domain}":
+   - mod-story
+  parents:
+  - "{domain}-reader"
+ "{domain}-reader":
+  domains:
+   "{domain}":
+   - read-story`))
+			Expect(err).To(BeNil())
+
+			resolver.Rbac = rbac
+			resolver.Store = memory.NewMemory()
+		})
+
+		Context("Adding a newspaper", func() {
+			It("should create its roles", func() {
+				_, err := resolver.Mutation().AddNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+
+				roles, err := rbac.GetRoles(nil)
+				Expect(err).To(BeNil())
+				Expect(roles).To(HaveKey("times-editor"))
+				Expect(roles).To(HaveKey("times-reader"))
+				Expect(roles["times-editor"].Parents).To(Equal([]string{"times-reader"}))
+
+				times := "times"
+				Expect(rbac.CheckDomain([]string{"times-editor"}, &times, "MOD_STORY")).To(BeTrue())
+				Expect(rbac.CheckDomain([]string{"times-editor"}, &times, "READ_STORY")).To(BeTrue())
+			})
+		})
+		Context("Adding a newspaper whose roles can't be created", func() {
+			It("should not keep the newspaper", func() {
+				_, err := rbac.Import(strings.NewReader(`
+templates:
+ "{domain}-editor":
+  parents:
+  - missing`), types.ImportMerge, false, nil)
+				Expect(err).To(BeNil())
+
+				_, err = resolver.Mutation().AddNewspaper(context.Background(), "times")
+				Expect(err).To(HaveOccurred())
+
+				_, err = resolver.Store.Newspaper("times")
+				Expect(errors.Is(err, domain.ErrNotFound)).To(BeTrue())
+			})
+		})
+		Context("Adding a newspaper with a reserved name", func() {
+			It("should fail and create no roles", func() {
+				before, err := rbac.GetRoles(nil)
+				Expect(err).To(BeNil())
+
+				for _, name := range []string{"*", "", "{domain}", "times//sport", "/times", "times/"} {
+					_, err = resolver.Mutation().AddNewspaper(context.Background(), name)
+					Expect(err).To(HaveOccurred(), name)
+
+					_, err = resolver.Store.Newspaper(name)
+					Expect(errors.Is(err, domain.ErrNotFound)).To(BeTrue(), name)
+				}
+
+				roles, err := rbac.GetRoles(nil)
+				Expect(err).To(BeNil())
+				Expect(roles).To(HaveLen(len(before)))
+				Expect(roles).NotTo(HaveKey("*-editor"))
+
+				times := "times"
+				Expect(rbac.CheckDomain([]string{"*-editor"}, &times, "MOD_STORY")).To(BeFalse())
+			})
+		})
+		Context("Adding a newspaper below another", func() {
+			BeforeEach(func() {
+				_, err := rbac.Import(strings.NewReader(`
+roles:
+ acme-admin:
+  domains:
+   acme:
+   - mod-newspaper`), types.ImportMerge, false, nil)
+				Expect(err).To(BeNil())
+			})
+
+			It("should inherit the roles of the one above", func() {
+				_, err := resolver.Mutation().AddNewspaper(context.Background(), "acme")
+				Expect(err).To(BeNil())
+
+				_, err = resolver.Mutation().AddNewspaper(userContext("alice", "acme-admin"), "acme/the-bugle")
+				Expect(err).To(BeNil())
+
+				roles, err := rbac.GetRoles(nil)
+				Expect(err).To(BeNil())
+				Expect(roles).To(HaveKey("acme/the-bugle-editor"))
+
+				bugle := "acme/the-bugle"
+				Expect(rbac.CheckDomain([]string{"acme-editor"}, &bugle, "MOD_STORY")).To(BeTrue())
+				Expect(rbac.CheckDomain([]string{"acme/the-bugle-editor"}, &bugle, "MOD_STORY")).To(BeTrue())
+			})
+			It("should need the one above", func() {
+				_, err := resolver.Mutation().AddNewspaper(userContext("alice", "acme-admin"), "acme/the-bugle")
+				Expect(errors.Is(err, domain.ErrNotFound)).To(BeTrue())
+
+				_, err = resolver.Store.Newspaper("acme/the-bugle")
+				Expect(errors.Is(err, domain.ErrNotFound)).To(BeTrue())
+			})
+			It("should need MOD_NEWSPAPER in the one above", func() {
+				_, err := resolver.Mutation().AddNewspaper(context.Background(), "acme")
+				Expect(err).To(BeNil())
+				_, err = resolver.Mutation().AddNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+
+				_, err = resolver.Mutation().AddNewspaper(userContext("alice", "acme-admin"), "times/sport")
+				Expect(err).To(MatchError("Access denied"))
+				_, err = resolver.Mutation().AddNewspaper(userContext("bob", "newspaper-admin"), "acme/the-bugle")
+				Expect(err).To(MatchError("Access denied"))
+
+				roles, err := rbac.GetRoles(nil)
+				Expect(err).To(BeNil())
+				Expect(roles).NotTo(HaveKey("times/sport-editor"))
+				Expect(roles).NotTo(HaveKey("acme/the-bugle-editor"))
+			})
+		})
+		Context("Deleting a newspaper", func() {
+			It("should remove its roles", func() {
+				_, err := resolver.Mutation().AddNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+				_, err = resolver.Mutation().AddNewspaper(context.Background(), "mail")
+				Expect(err).To(BeNil())
+
+				ok, err := resolver.Mutation().DeleteNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+				Expect(ok).To(BeTrue())
+
+				roles, _ := rbac.GetRoles(nil)
+				Expect(roles).NotTo(HaveKey("times-editor"))
+				Expect(roles).NotTo(HaveKey("times-reader"))
+				Expect(roles).To(HaveKey("mail-editor"))
+				Expect(rbac.GetPermissions(nil)).To(ContainElement("mod-story"))
+			})
+			It("should only remove the newspaper's own permissions", func() {
+				_, err := rbac.Import(strings.NewReader(`
+permissions:
+- jwt-query
+roles:
+ jwt:
+  permissions:
+  - jwt-query
+ night-desk:
+  permissions:
+  - times-late-edition
+templates:
+ "{domain}-editor":
+  permissions:
+  - jwt-query
+  - "{domain}-late-edition"
+  - "{domain}-print"
+  domains:
+   "{domain}":
+   - mod-story`), types.ImportMerge, false, nil)
+				Expect(err).To(BeNil())
+
+				_, err = resolver.Mutation().AddNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+				_, err = resolver.Mutation().DeleteNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+
+				// shared by every newspaper's editors and the global jwt role
+				Expect(rbac.Check([]string{"jwt"}, "JWT_QUERY")).To(BeTrue())
+				// still granted by a role the newspaper didn't get from the templates
+				Expect(rbac.Check([]string{"night-desk"}, "TIMES_LATE_EDITION")).To(BeTrue())
+
+				permissions, err := rbac.GetPermissions(nil)
+				Expect(err).To(BeNil())
+				Expect(permissions).NotTo(ContainElement("times-print"))
+				Expect(permissions).To(ContainElement("mod-story"))
+			})
+			It("should skip roles already removed", func() {
+				_, err := resolver.Mutation().AddNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+				name := "times-editor"
+				_, err = rbac.DeleteRole(&name, nil)
+				Expect(err).To(BeNil())
+
+				_, err = resolver.Mutation().DeleteNewspaper(context.Background(), "times")
+				Expect(err).To(BeNil())
+			})
+		})
+	})
 	Describe("Payload", func() {
+		BeforeEach(func() {
+			resolver.Store = memory.NewMemory()
+			_, err := resolver.Store.AddNewspaper("test")
+			Expect(err).To(BeNil())
+		})
+
 		Context("Can add newspaper", func() {
 			It("should succeed", func() {
-				ret, err := resolver.Mutation().AddNewspaper(context.Background(), "test")
+				ret, err := resolver.Mutation().AddNewspaper(context.Background(), "bugle")
 
 				Expect(err).To(BeNil())
-				Expect(ret).To(Equal("Add Newspaper"))
+				Expect(ret).To(Equal("bugle"))
+			})
+		})
+		Context("Can't add newspaper twice", func() {
+			It("should fail", func() {
+				_, err := resolver.Mutation().AddNewspaper(context.Background(), "test")
+
+				Expect(errors.Is(err, domain.ErrExists)).To(BeTrue())
 			})
 		})
 		Context("Can delete newspaper", func() {
@@ -185,51 +714,153 @@ var _ = Describe("Schema.Resolvers", func() {
 		})
 		Context("Can add staff", func() {
 			It("should succeed", func() {
-				ret, err := resolver.Mutation().AddStaff(context.Background(), model.ModStaff{})
+				ret, err := resolver.Mutation().AddStaff(context.Background(), model.ModStaff{Newspaper: "test", Name: "alice"})
 
 				Expect(err).To(BeNil())
-				Expect(ret).To(Equal("Add Staff"))
+				Expect(ret).To(Equal("alice"))
 			})
 		})
-		Context("Can delete newspaper", func() {
+		Context("Can delete staff", func() {
 			It("should succeed", func() {
-				ret, err := resolver.Mutation().DeleteStaff(context.Background(), model.ModStaff{})
+				_, err := resolver.Mutation().AddStaff(context.Background(), model.ModStaff{Newspaper: "test", Name: "alice"})
+				Expect(err).To(BeNil())
+
+				ret, err := resolver.Mutation().DeleteStaff(context.Background(), model.ModStaff{Newspaper: "test", Name: "alice"})
 
 				Expect(err).To(BeNil())
 				Expect(ret).To(BeTrue())
 			})
 		})
-		Context("Can add photo", func() {
+		Context("Can add and delete photo", func() {
 			It("should succeed", func() {
-				ret, err := resolver.Mutation().AddPhoto(context.Background(), model.AddPhoto{})
+				uuid, err := resolver.Mutation().AddPhoto(context.Background(), model.AddPhoto{Newspaper: "test", Caption: "Caption", Filename: "photo.jpg"})
+				Expect(err).To(BeNil())
+				Expect(uuid).To(HaveLen(36))
+
+				ret, err := resolver.Mutation().DeletePhoto(context.Background(), model.DeleteMedia{Newspaper: "test", UUID: uuid})
 
 				Expect(err).To(BeNil())
-				Expect(ret).To(Equal("Add Photo"))
+				Expect(ret).To(BeTrue())
 			})
 		})
-		Context("Can delete photo", func() {
+		Context("Can add and delete story", func() {
 			It("should succeed", func() {
-				ret, err := resolver.Mutation().DeletePhoto(context.Background(), model.DeleteMedia{})
+				uuid, err := resolver.Mutation().AddStory(context.Background(), model.AddStory{Newspaper: "test", Headline: "Headline", Story: "Story"})
+				Expect(err).To(BeNil())
+				Expect(uuid).To(HaveLen(36))
+
+				ret, err := resolver.Mutation().DeleteStory(context.Background(), model.DeleteMedia{Newspaper: "test", UUID: uuid})
 
 				Expect(err).To(BeNil())
 				Expect(ret).To(BeTrue())
 			})
 		})
-		Context("Can add story", func() {
+		Context("Can find and delete stories by id", func() {
 			It("should succeed", func() {
-				ret, err := resolver.Mutation().AddStory(context.Background(), model.AddStory{})
+				first, err := resolver.Mutation().AddStory(context.Background(), model.AddStory{Newspaper: "test", Headline: "First", Story: "Story"})
+				Expect(err).To(BeNil())
+				second, err := resolver.Mutation().AddStory(context.Background(), model.AddStory{Newspaper: "test", Headline: "Second", Story: "Story"})
+				Expect(err).To(BeNil())
 
+				story, err := resolver.Query().Story(context.Background(), first)
 				Expect(err).To(BeNil())
-				Expect(ret).To(Equal("Add Story"))
+				Expect(story.Headline).To(Equal("First"))
+
+				deleted, err := resolver.Mutation().DeleteStories(context.Background(), []string{first, second})
+				Expect(err).To(BeNil())
+				Expect(deleted).To(Equal(2))
+
+				_, err = resolver.Query().Story(context.Background(), first)
+				Expect(errors.Is(err, domain.ErrNotFound)).To(BeTrue())
 			})
 		})
-		Context("Can delete story", func() {
+		Context("Can find a photo by id", func() {
 			It("should succeed", func() {
-				ret, err := resolver.Mutation().DeleteStory(context.Background(), model.DeleteMedia{})
+				uuid, err := resolver.Mutation().AddPhoto(context.Background(), model.AddPhoto{Newspaper: "test", Caption: "Caption", Filename: "photo.jpg"})
+				Expect(err).To(BeNil())
 
+				photo, err := resolver.Query().Photo(context.Background(), uuid)
 				Expect(err).To(BeNil())
-				Expect(ret).To(BeTrue())
+				Expect(photo.Newspaper).To(Equal("test"))
+			})
+		})
+		Context("Can search stories", func() {
+			It("should match the headline in any newspaper", func() {
+				_, err := resolver.Store.AddNewspaper("other")
+				Expect(err).To(BeNil())
+				for _, paper := range []string{"test", "other"} {
+					_, err = resolver.Mutation().AddStory(context.Background(), model.AddStory{Newspaper: paper, Headline: "Big News", Story: "Story"})
+					Expect(err).To(BeNil())
+					_, err = resolver.Mutation().AddStory(context.Background(), model.AddStory{Newspaper: paper, Headline: "Weather", Story: "Story"})
+					Expect(err).To(BeNil())
+				}
+
+				headline := "news"
+				stories, err := resolver.Query().SearchStories(context.Background(), model.StoryFilter{Newspapers: []string{"test", "other", "test"}, Headline: &headline})
+				Expect(err).To(BeNil())
+				Expect(stories).To(HaveLen(2))
+				Expect(stories[0].Newspaper).To(Equal("test"))
+				Expect(stories[1].Newspaper).To(Equal("other"))
+			})
+		})
+		Context("Can list newspapers", func() {
+			It("should list them all, FilterRbacDomain drops the unreadable ones", func() {
+				papers, err := resolver.Query().Newspapers(context.Background())
+				Expect(err).To(BeNil())
+				Expect(papers).To(HaveLen(1))
+			})
+		})
+		Context("Can read a newspaper", func() {
+			It("should succeed", func() {
+				_, err := resolver.Mutation().AddStaff(context.Background(), model.ModStaff{Newspaper: "test", Name: "alice"})
+				Expect(err).To(BeNil())
+				_, err = resolver.Mutation().AddStory(context.Background(), model.AddStory{Newspaper: "test", Headline: "Headline", Story: "Story"})
+				Expect(err).To(BeNil())
+				_, err = resolver.Mutation().AddPhoto(context.Background(), model.AddPhoto{Newspaper: "test", Caption: "Caption", Filename: "photo.jpg"})
+				Expect(err).To(BeNil())
+
+				paper, err := resolver.Query().Newspaper(context.Background(), "test")
+				Expect(err).To(BeNil())
+				Expect(paper.Name).To(Equal("test"))
+
+				staff, err := resolver.Query().Staff(context.Background(), "test")
+				Expect(err).To(BeNil())
+				Expect(staff).To(HaveLen(1))
+
+				stories, err := resolver.Query().Stories(context.Background(), "test")
+				Expect(err).To(BeNil())
+				Expect(stories[0].Headline).To(Equal("Headline"))
+
+				photos, err := resolver.Query().Photos(context.Background(), "test")
+				Expect(err).To(BeNil())
+				Expect(photos[0].Filename).To(Equal("photo.jpg"))
+			})
+		})
+		Context("Can't read an unknown newspaper", func() {
+			It("should fail", func() {
+				_, err := resolver.Query().Stories(context.Background(), "invalid")
+
+				Expect(errors.Is(err, domain.ErrNotFound)).To(BeTrue())
+			})
+		})
+		Context("Can't delete unknown story", func() {
+			It("should fail", func() {
+				_, err := resolver.Mutation().DeleteStory(context.Background(), model.DeleteMedia{Newspaper: "test", UUID: "invalid"})
+
+				Expect(errors.Is(err, domain.ErrNotFound)).To(BeTrue())
 			})
 		})
 	})
 })
+
+// userContext is a context as the JWT middleware leaves it for a valid token
+func userContext(user string, roles ...string) context.Context {
+	claims := jwt.MapClaims{
+		"user":  user,
+		"roles": make([]interface{}, 0, len(roles)),
+	}
+	for _, r := range roles {
+		claims["roles"] = append(claims["roles"].([]interface{}), r)
+	}
+	return context.WithValue(context.Background(), graph.JwtTokenField, &jwt.Token{Claims: claims, Valid: true})
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+type tenantKey struct{}
+
+type domainKey struct{}
+
+// WithTenantHeader keeps the TenantHeader of the request for HasRbacDomain(tenant: header)
+func WithTenantHeader(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// Tenant is the domain the request is for, from the token's TenantClaim or the TenantHeader
+// The claim can only be trusted as the token is verified, the header only if something in front of the server sets it
+func Tenant(ctx context.Context, from model.Tenant) (string, bool) {
+	var tenant string
+	switch from {
+	case model.TenantClaim:
+		if token, ok := ctx.Value(JwtTokenField).(*jwt.Token); ok && token.Valid {
+			if claims, ok := token.Claims.(jwt.MapClaims); ok {
+				tenant, _ = claims[TenantClaim].(string)
+			}
+		}
+	case model.TenantHeader:
+		tenant, _ = ctx.Value(tenantKey{}).(string)
+	}
+	return tenant, tenant != ""
+}
+
+// WithDomain records the domain HasRbacDomain allowed, for the resolver to act on
+func WithDomain(ctx context.Context, domain string) context.Context {
+	return context.WithValue(ctx, domainKey{}, domain)
+}
+
+// CheckedDomain is the domain HasRbacDomain(tenant) allowed, resolvers use it rather than finding the tenant
+// themselves so they can only act on the domain which was checked
+func CheckedDomain(ctx context.Context) (string, error) {
+	if domain, ok := ctx.Value(domainKey{}).(string); ok {
+		return domain, nil
+	}
+	return "", fmt.Errorf("No domain checked")
+}
@@ -0,0 +1,106 @@
+package graph_test
+
+import (
+	"context"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/memory"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/dummy"
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tenant", func() {
+	tenantContext := func(tenant string, valid bool) context.Context {
+		claims := jwt.MapClaims{"user": "alice", "roles": []interface{}{}, graph.TenantClaim: tenant}
+		return context.WithValue(context.Background(), graph.JwtTokenField, &jwt.Token{Claims: claims, Valid: valid})
+	}
+
+	Context("From the token", func() {
+		It("should use the claim", func() {
+			tenant, ok := graph.Tenant(tenantContext("times", true), model.TenantClaim)
+			Expect(ok).To(BeTrue())
+			Expect(tenant).To(Equal("times"))
+			Expect(graph.GetCurrentUser(tenantContext("times", true)).Tenant).To(Equal("times"))
+		})
+		It("should not trust an invalid token", func() {
+			_, ok := graph.Tenant(tenantContext("times", false), model.TenantClaim)
+			Expect(ok).To(BeFalse())
+		})
+		It("should not use the header", func() {
+			_, ok := graph.Tenant(graph.WithTenantHeader(context.Background(), "times"), model.TenantClaim)
+			Expect(ok).To(BeFalse())
+		})
+	})
+	Context("From the header", func() {
+		It("should use the header", func() {
+			tenant, ok := graph.Tenant(graph.WithTenantHeader(context.Background(), "times"), model.TenantHeader)
+			Expect(ok).To(BeTrue())
+			Expect(tenant).To(Equal("times"))
+		})
+		It("should not use the claim", func() {
+			_, ok := graph.Tenant(tenantContext("times", true), model.TenantHeader)
+			Expect(ok).To(BeFalse())
+		})
+	})
+	Context("Resolvers", func() {
+		var (
+			resolver *graph.Resolver
+		)
+		BeforeEach(func() {
+			resolver = &graph.Resolver{Store: memory.NewMemory(), Rbac: &dummy.Dummy{}}
+			_, err := resolver.Store.AddNewspaper("times")
+			Expect(err).To(BeNil())
+		})
+		It("should act on the checked domain", func() {
+			ctx := graph.WithDomain(context.Background(), "times")
+			_, err := resolver.Mutation().AddTenantStory(ctx, "Headline", "Story")
+			Expect(err).To(BeNil())
+
+			stories, err := resolver.Query().TenantStories(ctx)
+			Expect(err).To(BeNil())
+			Expect(stories).To(HaveLen(1))
+			Expect(stories[0].Newspaper).To(Equal("times"))
+		})
+		It("should fail without one", func() {
+			_, err := resolver.Query().TenantPhotos(context.Background())
+			Expect(err).To(MatchError("No domain checked"))
+		})
+		It("should change the roles of the checked tenant", func() {
+			ctx := graph.WithDomain(context.Background(), "times")
+			role, err := resolver.Mutation().UpsertTenantRole(ctx, model.AddRole{Name: "night-desk"})
+			Expect(err).To(BeNil())
+			Expect(role.Name).To(Equal("night-desk"))
+
+			ok, err := resolver.Mutation().DeleteTenantRole(ctx, model.DeleteRole{Name: "night-desk"})
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeTrue())
+
+			roles, err := resolver.Query().TenantRoles(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(roles).To(HaveLen(2))
+
+			perms, err := resolver.Query().TenantPermissions(ctx)
+			Expect(err).To(BeNil())
+			Expect(perms).To(Equal([]string{"Perm3"}))
+		})
+		It("should not change tenant roles without one", func() {
+			_, err := resolver.Mutation().UpsertTenantRole(context.Background(), model.AddRole{Name: "night-desk"})
+			Expect(err).To(MatchError("No domain checked"))
+			_, err = resolver.Query().TenantRoles(context.Background(), nil)
+			Expect(err).To(MatchError("No domain checked"))
+		})
+		It("should put the tenant in new tokens", func() {
+			resolver.JwtSecret = graph.JwtSecret
+			tenant := "times"
+			token, err := resolver.Mutation().CreateJwt(context.Background(), model.NewJwt{User: "alice", Roles: []string{}, Tenant: &tenant})
+			Expect(err).To(BeNil())
+
+			jwt, err := resolver.Query().Jwt(context.Background(), token)
+			Expect(err).To(BeNil())
+			Expect(jwt.Properties).To(ContainElement(&model.Property{Name: graph.TenantClaim, Value: "times"}))
+		})
+	})
+})
@@ -1,8 +1,15 @@
 package graph
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	domain "github.com/JeremyMarshall/gqlgen-jwt/domain/types"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	jwt "github.com/dgrijalva/jwt-go"
 )
 
 const (
@@ -12,13 +19,55 @@ const (
 	JwtTokenField = "user"
 	DefaultPort   = "8088"
 	GorbacYaml    = "./all.yaml"
+	Backups       = 5
+	Watch         = true
+	Strict        = false
+	GraphFormat   = "dot"
+	Database      = ""
+	TenantClaim   = "tenant"
+	TenantHeader  = "X-Tenant"
 )
 
+type User struct {
+	User  string
+	Roles []string
+	// Tenant is the TenantClaim, empty if the token doesn't have one
+	Tenant string
+}
+
+func GetCurrentUser(ctx context.Context) *User {
+	if rawToken := ctx.Value(JwtTokenField); rawToken != nil {
+		token := rawToken.(*jwt.Token)
+
+		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+			u := &User{
+				User:  claims["user"].(string),
+				Roles: make([]string, 0),
+			}
+			for _, r := range claims["roles"].([]interface{}) {
+				u.Roles = append(u.Roles, fmt.Sprint(r))
+			}
+			u.Tenant, _ = claims[TenantClaim].(string)
+			return u
+		}
+	}
+	return &User{}
+}
+
+// newMutation records the current user against a policy change
+func newMutation(ctx context.Context, expectedVersion *int) *types.Mutation {
+	return &types.Mutation{
+		User:            GetCurrentUser(ctx).User,
+		ExpectedVersion: expectedVersion,
+	}
+}
+
 func convertRole(k string, v types.Role) *model.Role {
 	r := &model.Role{
 		Name:        k,
 		Permissions: make([]*string, 0),
 		Parents:     make([]*string, 0),
+		Version:     v.Version,
 	}
 
 	for i := range v.Permissions {
@@ -29,5 +78,153 @@ func convertRole(k string, v types.Role) *model.Role {
 		r.Parents = append(r.Parents, &v.Parents[i])
 	}
 
+	r.Grants = convertGrants(v.Grants())
+
 	return r
 }
+
+func convertGrants(v []types.Grant) []*model.Grant {
+	ret := make([]*model.Grant, 0, len(v))
+	for _, g := range v {
+		ret = append(ret, &model.Grant{
+			Domain:     g.Domain,
+			Permission: g.Permission,
+		})
+	}
+	return ret
+}
+
+// RbacValues is every permission the schema can ask for
+func RbacValues() []string {
+	ret := make([]string, 0, len(model.AllRbac))
+	for _, v := range model.AllRbac {
+		ret = append(ret, v.String())
+	}
+	return ret
+}
+
+// convertRoles sorts by name as the map order changes each time
+func convertRoles(roles map[string]types.Role) []*model.Role {
+	names := make([]string, 0, len(roles))
+	for k := range roles {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	ret := make([]*model.Role, 0)
+	for _, k := range names {
+		ret = append(ret, convertRole(k, roles[k]))
+	}
+	return ret
+}
+
+func convertChange(c *model.PolicyChange) types.Change {
+	ret := types.Change{
+		Op:          types.ChangeOp(c.Op.String()),
+		Name:        c.Name,
+		Permissions: make([]string, 0),
+		Parents:     make([]string, 0),
+	}
+
+	for _, p := range c.Permissions {
+		if p != nil {
+			ret.Permissions = append(ret.Permissions, *p)
+		}
+	}
+
+	for _, p := range c.Parents {
+		if p != nil {
+			ret.Parents = append(ret.Parents, *p)
+		}
+	}
+
+	for _, g := range c.Grants {
+		if g == nil {
+			continue
+		}
+		if ret.Domains == nil {
+			ret.Domains = make(map[string][]string)
+		}
+		ret.Domains[g.Domain] = append(ret.Domains[g.Domain], g.Permission)
+	}
+
+	if c.Permission != nil {
+		ret.Permission = *c.Permission
+	}
+
+	if c.Domain != nil {
+		ret.Domain = *c.Domain
+	}
+
+	return ret
+}
+
+func convertRevision(v types.Revision) *model.Revision {
+	return &model.Revision{
+		Version: v.Version,
+		User:    v.User,
+		Time:    v.Time.Format(time.RFC3339),
+		Change:  v.Change,
+	}
+}
+
+func convertPolicyDiff(v types.PolicyDiff) *model.PolicyDiff {
+	d := &model.PolicyDiff{
+		AddedRoles:         v.AddedRoles,
+		RemovedRoles:       v.RemovedRoles,
+		ChangedRoles:       make([]*model.RoleDiff, 0),
+		AddedPermissions:   v.AddedPermissions,
+		RemovedPermissions: v.RemovedPermissions,
+	}
+
+	for _, r := range v.ChangedRoles {
+		d.ChangedRoles = append(d.ChangedRoles, &model.RoleDiff{
+			Name:               r.Name,
+			AddedPermissions:   r.AddedPermissions,
+			RemovedPermissions: r.RemovedPermissions,
+			AddedParents:       r.AddedParents,
+			RemovedParents:     r.RemovedParents,
+			AddedGrants:        convertGrants(r.AddedGrants),
+			RemovedGrants:      convertGrants(r.RemovedGrants),
+		})
+	}
+
+	return d
+}
+
+func convertStaff(v []domain.Staff) []*model.Staff {
+	ret := make([]*model.Staff, 0, len(v))
+	for _, s := range v {
+		ret = append(ret, &model.Staff{
+			Newspaper: s.Newspaper,
+			Name:      s.Name,
+		})
+	}
+	return ret
+}
+
+func convertStories(v []domain.Story) []*model.Story {
+	ret := make([]*model.Story, 0, len(v))
+	for _, s := range v {
+		ret = append(ret, &model.Story{
+			UUID:      s.UUID,
+			Newspaper: s.Newspaper,
+			Headline:  s.Headline,
+			Story:     s.Story,
+		})
+	}
+	return ret
+}
+
+func convertPhotos(v []domain.Photo) []*model.Photo {
+	ret := make([]*model.Photo, 0, len(v))
+	for _, p := range v {
+		ret = append(ret, &model.Photo{
+			UUID:      p.UUID,
+			Newspaper: p.Newspaper,
+			Caption:   p.Caption,
+			Filename:  p.Filename,
+		})
+	}
+	return ret
+}
@@ -3,23 +3,31 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/memory"
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/sqlite"
+	domain "github.com/JeremyMarshall/gqlgen-jwt/domain/types"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph/generated"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/gorbac"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/persist"
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
 	jwtmiddleware "github.com/auth0/go-jwt-middleware"
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/gorilla/handlers"
 	"github.com/namsral/flag"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -47,65 +55,242 @@ func AuthMiddleware(next http.Handler, secret string) http.Handler {
 			// w.Write([]byte(fmt.Sprintf("401 - %s", err)))
 		},
 	})
-	return jwtMiddleware.Handler(next)
+	// keep the tenant header for HasRbacDomain(tenant: header)
+	tenant := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if t := r.Header.Get(graph.TenantHeader); t != "" {
+			r = r.WithContext(graph.WithTenantHeader(r.Context(), t))
+		}
+		next.ServeHTTP(w, r)
+	})
+	return jwtMiddleware.Handler(tenant)
 }
 
-type User struct {
-	User  string
-	Roles []string
+type RbacMiddlewareFunc func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac *model.Rbac, anyOf []model.Rbac, allOf []model.Rbac) (res interface{}, err error)
+type RbacDomainMiddlewareFunc func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac *model.Rbac, anyOf []model.Rbac, allOf []model.Rbac, domainField *model.Domain, path *string, lookup *model.DomainLookup, tenant *model.Tenant) (res interface{}, err error)
+type MaskMiddlewareFunc func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, replacement *string, domainField *string) (res interface{}, err error)
+type FilterMiddlewareFunc func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, domainField string) (res interface{}, err error)
+type RbacResultMiddlewareFunc func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, domainField string) (res interface{}, err error)
+
+// RbacMiddleware needs rbac and every one of allOf, and one of anyOf if it is given
+func RbacMiddleware(rbacChecker types.Rbac) RbacMiddlewareFunc {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac *model.Rbac, anyOf []model.Rbac, allOf []model.Rbac) (res interface{}, err error) {
+		check := func(permission string) bool {
+			return graph.Check(ctx, rbacChecker, permission)
+		}
+		if !graph.NewRequirement(rbac, anyOf, allOf).Met(check) {
+			// block calling the next resolver
+			return nil, fmt.Errorf("Access denied")
+		}
+
+		// or let it pass through
+		return next(ctx)
+	}
 }
 
-func GetCurrentUser(ctx context.Context) *User {
-	if rawToken := ctx.Value(graph.JwtTokenField); rawToken != nil {
-		token := rawToken.(*jwt.Token)
+// RbacDomainMiddleware needs the permissions in every domain the directive finds, and at least one
+// lookups map ids to their domains for the directive's lookup argument
+func RbacDomainMiddleware(rbacChecker types.Rbac, lookups map[model.DomainLookup]graph.DomainLookup) RbacDomainMiddlewareFunc {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac *model.Rbac, anyOf []model.Rbac, allOf []model.Rbac, domainField *model.Domain, path *string, lookup *model.DomainLookup, tenant *model.Tenant) (res interface{}, err error) {
+		required := graph.NewRequirement(rbac, anyOf, allOf)
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			u := &User{
-				User:  claims["user"].(string),
-				Roles: make([]string, 0),
+		// the tenant comes from the request, not the arguments, so callers can't choose it
+		if tenant != nil {
+			domain, ok := graph.Tenant(ctx, *tenant)
+			if !ok || !checkDomains(ctx, rbacChecker, []string{domain}, required) {
+				return nil, fmt.Errorf("Access denied")
 			}
-			for _, r := range claims["roles"].([]interface{}) {
-				u.Roles = append(u.Roles, fmt.Sprint(r))
+			return next(graph.WithDomain(ctx, domain))
+		}
+
+		field := ""
+		if domainField != nil {
+			field = domainField.String()
+		}
+		if path != nil {
+			field = *path
+		}
+
+		var find graph.DomainLookup
+		if lookup != nil {
+			if find = lookups[*lookup]; find == nil {
+				return nil, fmt.Errorf("Access denied")
 			}
-			return u
 		}
+
+		// a lookup failing is denied too so ids in other domains can't be told from missing ones
+		domains, err := graph.Domains(ctx, obj, field, find)
+		if err != nil || !checkDomains(ctx, rbacChecker, domains, required) {
+			return nil, fmt.Errorf("Access denied")
+		}
+		return next(ctx)
 	}
-	return &User{}
 }
 
-type RbacMiddlewareFunc func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac) (res interface{}, err error)
-type RbacDomainMiddlewareFunc func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, domainFiled model.Domain) (res interface{}, err error)
+// checkDomains needs the requirement met in every domain, and at least one
+func checkDomains(ctx context.Context, rbacChecker types.Rbac, domains []string, required graph.Requirement) bool {
+	if len(domains) == 0 {
+		return false
+	}
+	for _, domain := range domains {
+		check := func(permission string) bool {
+			return graph.CheckDomain(ctx, rbacChecker, domain, permission)
+		}
+		if !required.Met(check) {
+			return false
+		}
+	}
+	return true
+}
 
-func RbacMiddleware(rbacChecker types.Rbac) RbacMiddlewareFunc {
-	return func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac) (res interface{}, err error) {
-		if !rbacChecker.Check(GetCurrentUser(ctx).Roles, rbac.String()) {
-			// block calling the next resolver
-			return nil, fmt.Errorf("Access denied")
+// MaskMiddleware resolves the field to the replacement, or null, unless the caller has the permission
+// With domainField the permission is checked in the domains in that field of the object the field is on
+func MaskMiddleware(rbacChecker types.Rbac) MaskMiddlewareFunc {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, replacement *string, domainField *string) (res interface{}, err error) {
+		if domainField == nil {
+			if !graph.Check(ctx, rbacChecker, rbac.String()) {
+				return graph.Masked(ctx, replacement), nil
+			}
+			return next(ctx)
 		}
 
-		// or let it pass through
+		// an object without the field is masked rather than shown
+		domains, err := graph.ObjectDomains(ctx, obj, *domainField, nil)
+		if err != nil || !checkDomains(ctx, rbacChecker, domains, graph.NewRequirement(&rbac, nil, nil)) {
+			return graph.Masked(ctx, replacement), nil
+		}
 		return next(ctx)
 	}
 }
 
-func RbacDomainMiddleware(rbacChecker types.Rbac) RbacDomainMiddlewareFunc {
-	return func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, domainString model.Domain) (res interface{}, err error) {
+// FilterMiddleware resolves the list then drops the items the caller doesn't have the permission for
+// in the domains in domainField of the item, the query only fails if the resolver does
+func FilterMiddleware(rbacChecker types.Rbac) FilterMiddlewareFunc {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, domainField string) (res interface{}, err error) {
+		res, err = next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return graph.Filter(res, func(item interface{}) bool {
+			domains, err := graph.ObjectDomains(ctx, item, domainField, nil)
+			return err == nil && checkDomains(ctx, rbacChecker, domains, graph.NewRequirement(&rbac, nil, nil))
+		})
+	}
+}
+
+// RbacResultMiddleware resolves the field then needs the permission in the domains in domainField of the result,
+// every item of a list, so fetching by id can't return something from another domain
+// Not found is denied too so ids in other domains can't be told from missing ones
+// The field has resolved before it is checked so it is only for queries, CheckSchema rejects it on mutations
+func RbacResultMiddleware(rbacChecker types.Rbac) RbacResultMiddlewareFunc {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, rbac model.Rbac, domainField string) (res interface{}, err error) {
+		res, err = next(ctx)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("Access denied")
+		}
+		if err != nil {
+			return nil, err
+		}
 
-		if args, ok := obj.(map[string]interface{}); ok {
-			if domain, ok := args[domainString.String()].(string); ok {
-				if rbacChecker.CheckDomain(GetCurrentUser(ctx).Roles, &domain, rbac.String()) {
-					return next(ctx)
-				}
+		// an empty list or null has nothing to check
+		domains, err := graph.ObjectDomains(ctx, res, domainField, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Access denied")
+		}
+		for _, d := range domains {
+			if !graph.CheckDomain(ctx, rbacChecker, d, rbac.String()) {
+				return nil, fmt.Errorf("Access denied")
 			}
 		}
-		return nil, fmt.Errorf("Access denied")
+		return res, nil
+	}
+}
+
+// Validate reports any problems with the policy read from reader to out
+// It returns false if the policy has errors which would stop it loading
+func Validate(reader io.Reader, out io.Writer) (bool, error) {
+	policy := &gorbac.Serialize{}
+	if err := gorbac.LoadYaml(reader, policy); err != nil {
+		return false, err
+	}
+
+	issues := gorbac.ValidatePolicy(policy, graph.RbacValues())
+	for _, i := range issues {
+		fmt.Fprintln(out, i)
+	}
+
+	return len(gorbac.Errors(issues)) == 0, nil
+}
+
+// RenderGraph writes the role graph of the policy read from reader to out
+// The policy needn't load, cycles are shown rather than rejected
+func RenderGraph(reader io.Reader, out io.Writer, format string) error {
+	policy := &gorbac.Serialize{}
+	if err := gorbac.LoadYaml(reader, policy); err != nil {
+		return err
 	}
+
+	return gorbac.NewRoleGraph(policy).Render(out, types.GraphFormat(strings.ToUpper(format)))
+}
+
+// CheckSchema reports every field in the schema guarded by a permission no role grants
+// It returns false if there are any, and an error if an rbac directive is misused whatever the policy
+func CheckSchema(schema *ast.Schema, rbac types.RbacQuery, out io.Writer) (bool, error) {
+	if misuses := graph.Misuses(schema); len(misuses) > 0 {
+		return false, fmt.Errorf("Invalid schema, %s", misuses[0])
+	}
+
+	ungranted, err := graph.Ungranted(schema, rbac)
+	if err != nil {
+		return false, err
+	}
+
+	for _, g := range ungranted {
+		fmt.Fprintf(out, "no role grants %s\n", g)
+	}
+
+	return len(ungranted) == 0, nil
+}
+
+// NewSchema is the executable schema with the rbac directives checking against the resolver's policy
+func NewSchema(resolver *graph.Resolver) graphql.ExecutableSchema {
+	return generated.NewExecutableSchema(generated.Config{
+		Resolvers: resolver,
+		Directives: generated.DirectiveRoot{
+			HasRbac:          RbacMiddleware(resolver.Rbac),
+			HasRbacDomain:    RbacDomainMiddleware(resolver.Rbac, resolver.DomainLookups()),
+			MaskUnlessRbac:   MaskMiddleware(resolver.Rbac),
+			FilterRbacDomain: FilterMiddleware(resolver.Rbac),
+			HasRbacResult:    RbacResultMiddleware(resolver.Rbac),
+		},
+	})
+}
+
+// NewServer serves the schema, remembering check results for each operation
+func NewServer(schema graphql.ExecutableSchema) *handler.Server {
+	srv := handler.NewDefaultServer(schema)
+	srv.AroundOperations(graph.MemoOperation)
+	return srv
+}
+
+// NewStore opens the sqlite store at path, or a memory one if path is empty
+func NewStore(path string) (domain.Store, error) {
+	if path == "" {
+		return memory.NewMemory(), nil
+	}
+	return sqlite.NewSqlite(path)
 }
 
 type opts struct {
 	Port       string
 	GorbacYaml string
 	JwtSecret  string
+	Backups    int
+	Watch      bool
+	Strict     bool
+	Format     string
+	Database   string
+	Command    string
 }
 
 func NewOpts() *opts {
@@ -114,9 +299,16 @@ func NewOpts() *opts {
 	flag.StringVar(&o.Port, "port", graph.DefaultPort, "Port number")
 	flag.StringVar(&o.JwtSecret, "jwtSecret", graph.JwtSecret, "JWT Secret")
 	flag.StringVar(&o.GorbacYaml, "gorbacYaml", graph.GorbacYaml, "RBAC yaml")
+	flag.IntVar(&o.Backups, "backups", graph.Backups, "Number of RBAC yaml backups to keep")
+	flag.BoolVar(&o.Watch, "watch", graph.Watch, "Reload RBAC yaml when it changes or on SIGHUP")
+	flag.BoolVar(&o.Strict, "strict", graph.Strict, "Refuse to start if the RBAC yaml doesn't grant every permission the schema needs")
+	flag.StringVar(&o.Format, "format", graph.GraphFormat, "Format for the graph command, dot, mermaid or json")
+	flag.StringVar(&o.Database, "database", graph.Database, "Sqlite file for newspapers, staff, stories and photos, kept in memory if empty")
 
 	flag.Parse()
 
+	o.Command = flag.Arg(0)
+
 	return o
 }
 
@@ -124,35 +316,87 @@ func main() {
 
 	opts := NewOpts()
 
-	f, err := os.Open(opts.GorbacYaml)
+	policy := persist.NewFile(opts.GorbacYaml, opts.Backups)
+
+	f, err := policy.Open()
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer f.Close()
 
+	switch opts.Command {
+	case "", "check":
+	case "validate":
+		ok, err := Validate(f, os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	case "graph":
+		if err := RenderGraph(f, os.Stdout, opts.Format); err != nil {
+			log.Fatal(err)
+		}
+		return
+	default:
+		log.Fatalf("Unknown command %s", opts.Command)
+	}
+
 	rbac, err := gorbac.NewRbac(f)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	for _, i := range rbac.Validate(graph.RbacValues()) {
+		log.Println(i)
+	}
+
+	store, err := NewStore(opts.Database)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer store.Close()
+
 	resolver := &graph.Resolver{
 		Rbac:      rbac,
 		JwtSecret: opts.JwtSecret,
-		Serialize: opts.GorbacYaml,
+		Serialize: policy,
+		Store:     store,
 	}
 
-	c := generated.Config{
-		Resolvers: resolver,
-		Directives: generated.DirectiveRoot{
-			HasRbac:       RbacMiddleware(rbac),
-			HasRbacDomain: RbacDomainMiddleware(rbac),
-		},
+	schema := NewSchema(resolver)
+
+	if opts.Command == "check" {
+		ok, err := CheckSchema(schema.Schema(), rbac, os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	}
+
+	ok, err := CheckSchema(schema.Schema(), rbac, log.Writer())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !ok && opts.Strict {
+		log.Fatal("RBAC yaml doesn't grant every permission the schema needs")
 	}
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(c))
+	if opts.Watch {
+		watcher, err := policy.Watch(rbac, nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer watcher.Close()
+	}
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", AuthMiddleware(handlers.LoggingHandler(os.Stdout, srv), opts.JwtSecret))
+	http.Handle("/query", AuthMiddleware(handlers.LoggingHandler(os.Stdout, NewServer(schema)), opts.JwtSecret))
 
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", opts.Port)
 	log.Fatal(http.ListenAndServe(":"+opts.Port, nil))
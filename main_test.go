@@ -9,12 +9,22 @@ import (
 	. "github.com/onsi/gomega"
 
 	"context"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JeremyMarshall/gqlgen-jwt/domain/memory"
+	domain "github.com/JeremyMarshall/gqlgen-jwt/domain/types"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph"
+	"github.com/JeremyMarshall/gqlgen-jwt/graph/generated"
 	"github.com/JeremyMarshall/gqlgen-jwt/graph/model"
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/dummy"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/gorbac"
 	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
 )
 
 var _ = Describe("Main", func() {
@@ -50,7 +60,7 @@ var _ = Describe("Main", func() {
 					val := r.Context().Value("user")
 					Expect(val).NotTo(BeNil())
 
-					user := GetCurrentUser(r.Context())
+					user := graph.GetCurrentUser(r.Context())
 
 					w.Header().Set("Content-Type", "application/json")
 					w.WriteHeader(http.StatusOK)
@@ -131,7 +141,343 @@ var _ = Describe("Main", func() {
 		})
 	})
 
+	Describe("domain queries", func() {
+		var (
+			c          *client.Client
+			bugleStory string
+			timesStory string
+		)
+		BeforeEach(func() {
+			f, err := os.Open("all.yaml")
+			Expect(err).To(BeNil())
+			defer f.Close()
+
+			resolver.Rbac, err = gorbac.NewRbac(f)
+			Expect(err).To(BeNil())
+			resolver.Store = memory.NewMemory()
+			for _, paper := range []string{"the-bugle", "the-times"} {
+				_, err = resolver.Store.AddNewspaper(paper)
+				Expect(err).To(BeNil())
+			}
+			story, err := resolver.Store.AddStory("the-bugle", "Headline", "Story")
+			Expect(err).To(BeNil())
+			bugleStory = story.UUID
+			story, err = resolver.Store.AddStory("the-times", "Headline", "Story")
+			Expect(err).To(BeNil())
+			timesStory = story.UUID
+
+			reader, err := resolver.Mutation().CreateJwt(context.Background(), model.NewJwt{User: "peter", Roles: []string{"the-bugle-reader"}})
+			Expect(err).To(BeNil())
+
+			c = client.New(AuthMiddleware(NewServer(NewSchema(resolver)), graph.JwtSecret), client.AddHeader("Authorization", "Bearer "+reader))
+		})
+
+		Context("reader of the domain", func() {
+			It("should read it", func() {
+				var resp struct {
+					Newspaper struct{ Name string }
+					Stories   []struct{ Headline string }
+				}
+				err := c.Post(`query { newspaper(name: "the-bugle") { name } stories(newspaper: "the-bugle") { headline } }`, &resp)
+				Expect(err).To(BeNil())
+				Expect(resp.Newspaper.Name).To(Equal("the-bugle"))
+				Expect(resp.Stories).To(HaveLen(1))
+			})
+			It("should only list readable newspapers", func() {
+				var resp struct {
+					Newspapers []struct{ Name string }
+				}
+				err := c.Post(`query { newspapers { name } }`, &resp)
+				Expect(err).To(BeNil())
+				Expect(resp.Newspapers).To(HaveLen(1))
+				Expect(resp.Newspapers[0].Name).To(Equal("the-bugle"))
+			})
+			It("should mask the filenames of photos it can't change", func() {
+				_, err := resolver.Store.AddPhoto("the-bugle", "Caption", "photo.jpg")
+				Expect(err).To(BeNil())
+
+				var resp struct {
+					Photos []struct{ Caption, Filename string }
+				}
+				err = c.Post(`query { photos(newspaper: "the-bugle") { caption filename } }`, &resp)
+				Expect(err).To(BeNil())
+				Expect(resp.Photos).To(HaveLen(1))
+				Expect(resp.Photos[0].Caption).To(Equal("Caption"))
+				Expect(resp.Photos[0].Filename).To(Equal(""))
+
+				photographer, err := resolver.Mutation().CreateJwt(context.Background(), model.NewJwt{User: "jimmy", Roles: []string{"the-bugle-photographer"}})
+				Expect(err).To(BeNil())
+				c = client.New(AuthMiddleware(NewServer(NewSchema(resolver)), graph.JwtSecret), client.AddHeader("Authorization", "Bearer "+photographer))
+				err = c.Post(`query { photos(newspaper: "the-bugle") { caption filename } }`, &resp)
+				Expect(err).To(BeNil())
+				Expect(resp.Photos[0].Filename).To(Equal("photo.jpg"))
+			})
+		})
+		Context("another domain", func() {
+			It("should be denied", func() {
+				var resp struct {
+					Staff []struct{ Name string }
+				}
+				err := c.Post(`query { staff(newspaper: "the-times") { name } }`, &resp)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+		})
+		Context("writing as a reader", func() {
+			It("should be denied", func() {
+				var resp struct {
+					AddStory string
+				}
+				err := c.Post(`mutation { addStory(input: {newspaper: "the-bugle", headline: "Headline", story: "Story"}) }`, &resp)
+				Expect(err).To(HaveOccurred())
+			})
+			It("should not delete stories found by id", func() {
+				var resp struct {
+					DeleteStories int
+				}
+				err := c.Post(`mutation($uuids: [String!]!) { deleteStories(uuids: $uuids) }`, &resp, client.Var("uuids", []string{bugleStory}))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+		})
+		Context("editor of the domain", func() {
+			It("should delete stories with either permission", func() {
+				editor, err := resolver.Mutation().CreateJwt(context.Background(), model.NewJwt{User: "perry", Roles: []string{"the-bugle-editor"}})
+				Expect(err).To(BeNil())
+				c = client.New(AuthMiddleware(NewServer(NewSchema(resolver)), graph.JwtSecret), client.AddHeader("Authorization", "Bearer "+editor))
+
+				var resp struct {
+					DeleteStories int
+				}
+				err = c.Post(`mutation($uuids: [String!]!) { deleteStories(uuids: $uuids) }`, &resp, client.Var("uuids", []string{bugleStory}))
+				Expect(err).To(BeNil())
+				Expect(resp.DeleteStories).To(Equal(1))
+
+				err = c.Post(`mutation($uuids: [String!]!) { deleteStories(uuids: $uuids) }`, &resp, client.Var("uuids", []string{timesStory}))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+		})
+		Context("fetching by id", func() {
+			It("should check the story's newspaper", func() {
+				var resp struct {
+					Story struct{ Newspaper string }
+				}
+				err := c.Post(`query($uuid: String!) { story(uuid: $uuid) { newspaper } }`, &resp, client.Var("uuid", bugleStory))
+				Expect(err).To(BeNil())
+				Expect(resp.Story.Newspaper).To(Equal("the-bugle"))
+
+				err = c.Post(`query($uuid: String!) { story(uuid: $uuid) { newspaper } }`, &resp, client.Var("uuid", timesStory))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+			It("should deny unknown ids", func() {
+				var resp struct {
+					Story struct{ Newspaper string }
+				}
+				err := c.Post(`query { story(uuid: "invalid") { newspaper } }`, &resp)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+			It("should not leak a photo from another domain", func() {
+				photo, err := resolver.Store.AddPhoto("the-times", "Caption", "photo.jpg")
+				Expect(err).To(BeNil())
+
+				var resp struct {
+					Photo struct{ Caption string }
+				}
+				err = c.Post(`query($uuid: String!) { photo(uuid: $uuid) { caption } }`, &resp, client.Var("uuid", photo.UUID))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+				Expect(resp.Photo.Caption).To(BeEmpty())
+			})
+		})
+		Context("a list of domains in a nested input", func() {
+			It("should check each of them", func() {
+				var resp struct {
+					SearchStories []struct{ Newspaper string }
+				}
+				err := c.Post(`query { searchStories(filter: {newspapers: ["the-bugle"], headline: "head"}) { newspaper } }`, &resp)
+				Expect(err).To(BeNil())
+				Expect(resp.SearchStories).To(HaveLen(1))
+
+				err = c.Post(`query { searchStories(filter: {newspapers: ["the-bugle", "the-times"]}) { newspaper } }`, &resp)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+		})
+	})
+
+	Describe("tenant domain", func() {
+		var (
+			server http.Handler
+		)
+		BeforeEach(func() {
+			f, err := os.Open("all.yaml")
+			Expect(err).To(BeNil())
+			defer f.Close()
+
+			resolver.Rbac, err = gorbac.NewRbac(f)
+			Expect(err).To(BeNil())
+			resolver.Store = memory.NewMemory()
+			for _, paper := range []string{"the-bugle", "the-times"} {
+				_, err = resolver.Store.AddNewspaper(paper)
+				Expect(err).To(BeNil())
+				_, err = resolver.Store.AddPhoto(paper, "Caption", "photo.jpg")
+				Expect(err).To(BeNil())
+			}
+
+			server = AuthMiddleware(NewServer(NewSchema(resolver)), graph.JwtSecret)
+		})
+
+		tokenFor := func(tenant *string, roles ...string) string {
+			token, err := resolver.Mutation().CreateJwt(context.Background(), model.NewJwt{User: "peter", Roles: roles, Tenant: tenant})
+			Expect(err).To(BeNil())
+			return token
+		}
+
+		Context("tenant claim", func() {
+			It("should check and use it", func() {
+				bugle := "the-bugle"
+				c := client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&bugle, "the-bugle-editor")))
+
+				var add struct {
+					AddTenantStory string
+				}
+				err := c.Post(`mutation { addTenantStory(headline: "Headline", story: "Story") }`, &add)
+				Expect(err).To(BeNil())
+
+				var resp struct {
+					TenantStories []struct{ Newspaper string }
+				}
+				err = c.Post(`query { tenantStories { newspaper } }`, &resp)
+				Expect(err).To(BeNil())
+				Expect(resp.TenantStories).To(HaveLen(1))
+				Expect(resp.TenantStories[0].Newspaper).To(Equal("the-bugle"))
+			})
+			It("should deny another tenant", func() {
+				times := "the-times"
+				c := client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&times, "the-bugle-reader")))
+
+				var resp struct {
+					TenantStories []struct{ Newspaper string }
+				}
+				err := c.Post(`query { tenantStories { newspaper } }`, &resp)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+			It("should deny a token without one, whatever the header says", func() {
+				c := client.New(server,
+					client.AddHeader("Authorization", "Bearer "+tokenFor(nil, "the-bugle-reader")),
+					client.AddHeader(graph.TenantHeader, "the-bugle"))
+
+				var resp struct {
+					TenantStories []struct{ Newspaper string }
+				}
+				err := c.Post(`query { tenantStories { newspaper } }`, &resp)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+		})
+		Context("tenant header", func() {
+			It("should check and use it", func() {
+				c := client.New(server,
+					client.AddHeader("Authorization", "Bearer "+tokenFor(nil, "the-bugle-reader")),
+					client.AddHeader(graph.TenantHeader, "the-bugle"))
+
+				var resp struct {
+					TenantPhotos []struct{ Newspaper string }
+				}
+				err := c.Post(`query { tenantPhotos { newspaper } }`, &resp)
+				Expect(err).To(BeNil())
+				Expect(resp.TenantPhotos).To(HaveLen(1))
+				Expect(resp.TenantPhotos[0].Newspaper).To(Equal("the-bugle"))
+			})
+			It("should deny another tenant", func() {
+				c := client.New(server,
+					client.AddHeader("Authorization", "Bearer "+tokenFor(nil, "the-bugle-reader")),
+					client.AddHeader(graph.TenantHeader, "the-times"))
+
+				var resp struct {
+					TenantPhotos []struct{ Newspaper string }
+				}
+				err := c.Post(`query { tenantPhotos { newspaper } }`, &resp)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+		})
+		Context("tenant roles", func() {
+			It("should let a tenant admin change their own tenant's roles", func() {
+				bugle := "the-bugle"
+				admin := client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&bugle, "the-bugle-admin")))
+
+				var upsert struct {
+					UpsertTenantRole struct{ Name string }
+				}
+				err := admin.Post(`mutation { upsertTenantRole(input: {name: "sub-editor", permissions: ["mod-story"]}) { name } }`, &upsert)
+				Expect(err).To(BeNil())
+				Expect(upsert.UpsertTenantRole.Name).To(Equal("sub-editor"))
+
+				editor := client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&bugle, "sub-editor")))
+				var add struct {
+					AddTenantStory string
+				}
+				err = editor.Post(`mutation { addTenantStory(headline: "Headline", story: "Story") }`, &add)
+				Expect(err).To(BeNil())
+			})
+			It("should not let a tenant admin change another tenant's roles", func() {
+				times := "the-times"
+				c := client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&times, "the-bugle-admin")))
+
+				var upsert struct {
+					UpsertTenantRole struct{ Name string }
+				}
+				err := c.Post(`mutation { upsertTenantRole(input: {name: "sub-editor", permissions: ["mod-story"]}) { name } }`, &upsert)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+			It("should not let a tenant admin change the global roles", func() {
+				bugle := "the-bugle"
+				c := client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&bugle, "the-bugle-admin")))
+
+				var upsert struct {
+					UpsertRole struct{ Name string }
+				}
+				err := c.Post(`mutation { upsertRole(input: {name: "sub-editor", permissions: ["mod-story"]}) { name } }`, &upsert)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+			It("should only grant a tenant's roles in the tenant", func() {
+				bugle, times := "the-bugle", "the-times"
+
+				var add struct {
+					AddTenantStory string
+				}
+				c := client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&bugle, "night-desk")))
+				err := c.Post(`mutation { addTenantStory(headline: "Headline", story: "Story") }`, &add)
+				Expect(err).To(BeNil())
+
+				c = client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&times, "night-desk")))
+				err = c.Post(`mutation { addTenantStory(headline: "Headline", story: "Story") }`, &add)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+
+				c = client.New(server, client.AddHeader("Authorization", "Bearer "+tokenFor(&bugle, "night-desk")))
+				var addStory struct {
+					AddStory string
+				}
+				err = c.Post(`mutation { addStory(input: {newspaper: "the-times", headline: "Headline", story: "Story"}) }`, &addStory)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Access denied"))
+			})
+		})
+	})
+
 	Describe("gql rbac middleware", func() {
+		var (
+			rbacMutate = model.RbacRbacMutate
+			denied     = model.Rbac("error")
+		)
 		Context("Role fulfils permission", func() {
 			It("should succeed", func() {
 				rbac := &dummy.Dummy{}
@@ -148,7 +494,7 @@ var _ = Describe("Main", func() {
 
 				ctx := context.WithValue(context.Background(), graph.JwtTokenField, token)
 
-				ok, err := rbw(ctx, nil, next, "RBAC_MUTATE")
+				ok, err := rbw(ctx, nil, next, &rbacMutate, nil, nil)
 				Expect(err).To(BeNil())
 				Expect(ok).To(BeTrue())
 
@@ -175,16 +521,74 @@ var _ = Describe("Main", func() {
 
 				ctx := context.WithValue(context.Background(), graph.JwtTokenField, token)
 
-				_, err = rbw(ctx, nil, next, "RBAC_MUTATE")
+				_, err = rbw(ctx, nil, next, &rbacMutate, nil, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
+		Context("Composing permissions", func() {
+			var (
+				ctx  context.Context
+				next = func(ctx context.Context) (res interface{}, err error) {
+					return true, nil
+				}
+			)
+			BeforeEach(func() {
+				token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+					return []byte(graph.JwtSecret), nil
+				})
+				Expect(err).To(BeNil())
+				ctx = context.WithValue(context.Background(), graph.JwtTokenField, token)
+			})
+			It("should need any one of anyOf", func() {
+				rbw := RbacMiddleware(&dummy.Dummy{})
+				_, err := rbw(ctx, nil, next, nil, []model.Rbac{denied, rbacMutate}, nil)
+				Expect(err).To(BeNil())
+
+				_, err = rbw(ctx, nil, next, nil, []model.Rbac{denied}, nil)
+				Expect(err).To(MatchError("Access denied"))
+			})
+			It("should need every one of allOf and rbac", func() {
+				rbw := RbacMiddleware(&dummy.Dummy{})
+				_, err := rbw(ctx, nil, next, &rbacMutate, nil, []model.Rbac{rbacMutate})
+				Expect(err).To(BeNil())
+
+				_, err = rbw(ctx, nil, next, &rbacMutate, nil, []model.Rbac{denied})
+				Expect(err).To(MatchError("Access denied"))
+				_, err = rbw(ctx, nil, next, &denied, nil, []model.Rbac{rbacMutate})
+				Expect(err).To(MatchError("Access denied"))
+				_, err = rbw(ctx, nil, next, &rbacMutate, []model.Rbac{denied}, nil)
+				Expect(err).To(MatchError("Access denied"))
+			})
+			It("should deny without any permissions", func() {
+				_, err := RbacMiddleware(&dummy.Dummy{})(ctx, nil, next, nil, nil, nil)
+				Expect(err).To(MatchError("Access denied"))
+			})
+			It("should need every stacked directive to pass", func() {
+				rbw := RbacMiddleware(&dummy.Dummy{})
+				inner := func(ctx context.Context) (interface{}, error) {
+					return rbw(ctx, nil, next, &rbacMutate, nil, nil)
+				}
+				_, err := rbw(ctx, nil, inner, &rbacMutate, nil, nil)
+				Expect(err).To(BeNil())
+
+				inner = func(ctx context.Context) (interface{}, error) {
+					return rbw(ctx, nil, next, &denied, nil, nil)
+				}
+				_, err = rbw(ctx, nil, inner, &rbacMutate, nil, nil)
+				Expect(err).To(MatchError("Access denied"))
+			})
+		})
 	})
 	Describe("gql rbac domain middleware", func() {
+		var (
+			newspaper  = model.DomainNewspaper
+			rbacMutate = model.RbacRbacMutate
+			denied     = model.Rbac("error")
+		)
 		Context("Role fulfils permission", func() {
 			It("should succeed", func() {
 				rbac := &dummy.Dummy{}
-				rbw := RbacDomainMiddleware(rbac)
+				rbw := RbacDomainMiddleware(rbac, nil)
 
 				next := func(ctx context.Context) (res interface{}, err error) {
 					return true, nil
@@ -197,7 +601,7 @@ var _ = Describe("Main", func() {
 
 				ctx := context.WithValue(context.Background(), graph.JwtTokenField, token)
 
-				ok, err := rbw(ctx, map[string]interface{}{"newspaper": "test"}, next, "RBAC_MUTATE", model.DomainNewspaper)
+				ok, err := rbw(ctx, map[string]interface{}{"newspaper": "test"}, next, &rbacMutate, nil, nil, &newspaper, nil, nil, nil)
 				Expect(err).To(BeNil())
 				Expect(ok).To(BeTrue())
 
@@ -207,7 +611,7 @@ var _ = Describe("Main", func() {
 		Context("Role doesn't fulfil permission", func() {
 			It("should fail", func() {
 				rbac := &dummy.Dummy{}
-				rbw := RbacDomainMiddleware(rbac)
+				rbw := RbacDomainMiddleware(rbac, nil)
 
 				next := func(ctx context.Context) (res interface{}, err error) {
 					return true, nil
@@ -224,10 +628,271 @@ var _ = Describe("Main", func() {
 
 				ctx := context.WithValue(context.Background(), graph.JwtTokenField, token)
 
-				_, err = rbw(ctx, map[string]interface{}{"newspaper": "test"}, next, "RBAC_MUTATE", model.DomainNewspaper)
+				_, err = rbw(ctx, map[string]interface{}{"newspaper": "test"}, next, &rbacMutate, nil, nil, &newspaper, nil, nil, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
+		Context("Composing permissions", func() {
+			It("should meet the requirement in every domain", func() {
+				rbw := RbacDomainMiddleware(&dummy.Dummy{}, nil)
+				next := func(ctx context.Context) (res interface{}, err error) {
+					return true, nil
+				}
+				token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+					return []byte(graph.JwtSecret), nil
+				})
+				Expect(err).To(BeNil())
+				ctx := context.WithValue(context.Background(), graph.JwtTokenField, token)
+
+				_, err = rbw(ctx, map[string]interface{}{"newspaper": "test"}, next, nil, []model.Rbac{denied, rbacMutate}, nil, &newspaper, nil, nil, nil)
+				Expect(err).To(BeNil())
+
+				_, err = rbw(ctx, map[string]interface{}{"newspaper": "test"}, next, &rbacMutate, nil, []model.Rbac{denied}, &newspaper, nil, nil, nil)
+				Expect(err).To(MatchError("Access denied"))
+
+				path := "newspapers"
+				_, err = rbw(ctx, map[string]interface{}{"newspapers": []interface{}{"test", "error"}}, next, nil, []model.Rbac{denied, rbacMutate}, nil, nil, &path, nil, nil)
+				Expect(err).To(MatchError("Access denied"))
+			})
+		})
+		Describe("mask", func() {
+			var (
+				next = func(ctx context.Context) (res interface{}, err error) {
+					return "photo.jpg", nil
+				}
+				replacement = "redacted"
+				newspaper   = "newspaper"
+			)
+			userContext := func(roles ...string) context.Context {
+				token, err := resolver.Mutation().CreateJwt(context.Background(), model.NewJwt{User: "aa", Roles: append([]string{}, roles...)})
+				Expect(err).To(BeNil())
+				parsed, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+					return []byte(graph.JwtSecret), nil
+				})
+				Expect(err).To(BeNil())
+				return context.WithValue(context.Background(), graph.JwtTokenField, parsed)
+			}
+
+			Context("Role fulfils permission", func() {
+				It("should resolve the field", func() {
+					mw := MaskMiddleware(&dummy.Dummy{})
+					res, err := mw(userContext("role1"), nil, next, "MOD_PHOTO", &replacement, nil)
+					Expect(err).To(BeNil())
+					Expect(res).To(Equal("photo.jpg"))
+
+					res, err = mw(userContext("role1"), &model.Photo{Newspaper: "test"}, next, "MOD_PHOTO", &replacement, &newspaper)
+					Expect(err).To(BeNil())
+					Expect(res).To(Equal("photo.jpg"))
+				})
+			})
+			Context("Role doesn't fulfil permission", func() {
+				It("should mask the field rather than fail", func() {
+					mw := MaskMiddleware(&dummy.Dummy{})
+					res, err := mw(userContext(), nil, next, "MOD_PHOTO", &replacement, nil)
+					Expect(err).To(BeNil())
+					Expect(res).To(Equal("redacted"))
+
+					res, err = mw(userContext(), nil, next, "MOD_PHOTO", nil, nil)
+					Expect(err).To(BeNil())
+					Expect(res).To(BeNil())
+				})
+				It("should mask it in a domain it can't see", func() {
+					mw := MaskMiddleware(&dummy.Dummy{})
+					res, err := mw(userContext("role1"), &model.Photo{Newspaper: "error"}, next, "MOD_PHOTO", &replacement, &newspaper)
+					Expect(err).To(BeNil())
+					Expect(res).To(Equal("redacted"))
+
+					missing := "missing"
+					res, err = mw(userContext("role1"), &model.Photo{Newspaper: "test"}, next, "MOD_PHOTO", &replacement, &missing)
+					Expect(err).To(BeNil())
+					Expect(res).To(Equal("redacted"))
+				})
+			})
+		})
+		Describe("filter", func() {
+			It("should drop the items in domains it can't see", func() {
+				token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+					return []byte(graph.JwtSecret), nil
+				})
+				Expect(err).To(BeNil())
+				ctx := context.WithValue(context.Background(), graph.JwtTokenField, token)
+
+				next := func(ctx context.Context) (res interface{}, err error) {
+					return []*model.Newspaper{{Name: "test"}, {Name: "error"}}, nil
+				}
+				res, err := FilterMiddleware(&dummy.Dummy{})(ctx, nil, next, "READ_NEWSPAPER", "name")
+				Expect(err).To(BeNil())
+				Expect(res).To(Equal([]*model.Newspaper{{Name: "test"}}))
+
+				res, err = FilterMiddleware(&dummy.Dummy{})(context.Background(), nil, next, "READ_NEWSPAPER", "name")
+				Expect(err).To(BeNil())
+				Expect(res).To(BeEmpty())
+			})
+			It("should fail if the resolver does", func() {
+				next := func(ctx context.Context) (res interface{}, err error) {
+					return nil, fmt.Errorf("Newspapers error")
+				}
+				_, err := FilterMiddleware(&dummy.Dummy{})(context.Background(), nil, next, "READ_NEWSPAPER", "name")
+				Expect(err).To(MatchError("Newspapers error"))
+			})
+		})
+		Describe("result", func() {
+			var (
+				ctx context.Context
+			)
+			BeforeEach(func() {
+				token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+					return []byte(graph.JwtSecret), nil
+				})
+				Expect(err).To(BeNil())
+				ctx = context.WithValue(context.Background(), graph.JwtTokenField, token)
+			})
+			resolved := func(res interface{}, err error) graphql.Resolver {
+				return func(ctx context.Context) (interface{}, error) {
+					return res, err
+				}
+			}
+
+			It("should return a result in a domain it can see", func() {
+				story := &model.Story{Newspaper: "test"}
+				res, err := RbacResultMiddleware(&dummy.Dummy{})(ctx, nil, resolved(story, nil), "READ_STORY", "newspaper")
+				Expect(err).To(BeNil())
+				Expect(res).To(Equal(story))
+			})
+			It("should deny a result in a domain it can't see", func() {
+				_, err := RbacResultMiddleware(&dummy.Dummy{})(ctx, nil, resolved(&model.Story{Newspaper: "error"}, nil), "READ_STORY", "newspaper")
+				Expect(err).To(MatchError("Access denied"))
+
+				_, err = RbacResultMiddleware(&dummy.Dummy{})(context.Background(), nil, resolved(&model.Story{Newspaper: "test"}, nil), "READ_STORY", "newspaper")
+				Expect(err).To(MatchError("Access denied"))
+			})
+			It("should check every item of a list", func() {
+				stories := []*model.Story{{Newspaper: "test"}, {Newspaper: "error"}}
+				_, err := RbacResultMiddleware(&dummy.Dummy{})(ctx, nil, resolved(stories, nil), "READ_STORY", "newspaper")
+				Expect(err).To(MatchError("Access denied"))
+
+				res, err := RbacResultMiddleware(&dummy.Dummy{})(ctx, nil, resolved([]*model.Story{}, nil), "READ_STORY", "newspaper")
+				Expect(err).To(BeNil())
+				Expect(res).To(BeEmpty())
+			})
+			It("should deny a result without the field", func() {
+				_, err := RbacResultMiddleware(&dummy.Dummy{})(ctx, nil, resolved(&model.Story{}, nil), "READ_STORY", "paper")
+				Expect(err).To(MatchError("Access denied"))
+			})
+			It("should deny not found but pass other errors on", func() {
+				_, err := RbacResultMiddleware(&dummy.Dummy{})(ctx, nil, resolved(nil, fmt.Errorf("Story a %w", domain.ErrNotFound)), "READ_STORY", "newspaper")
+				Expect(err).To(MatchError("Access denied"))
+
+				_, err = RbacResultMiddleware(&dummy.Dummy{})(ctx, nil, resolved(nil, fmt.Errorf("Store error")), "READ_STORY", "newspaper")
+				Expect(err).To(MatchError("Store error"))
+			})
+		})
+		Describe("validate", func() {
+			Context("valid policy", func() {
+				It("should succeed", func() {
+					out := new(bytes.Buffer)
+					ok, err := Validate(strings.NewReader(`
+permissions:
+- jwt-query
+- del-text
+roles:
+  jwt:
+    permissions:
+    - jwt-query`), out)
+					Expect(err).To(BeNil())
+					Expect(ok).To(BeTrue())
+					Expect(out.String()).To(ContainSubstring("warning: unused-permission"))
+				})
+			})
+			Context("invalid policy", func() {
+				It("should fail", func() {
+					out := new(bytes.Buffer)
+					ok, err := Validate(strings.NewReader(`
+roles:
+  jwt:
+    permissions:
+    - jwt-query`), out)
+					Expect(err).To(BeNil())
+					Expect(ok).To(BeFalse())
+					Expect(out.String()).To(ContainSubstring("error: undeclared-permission"))
+				})
+			})
+			Context("unreadable policy", func() {
+				It("should error", func() {
+					_, err := Validate(strings.NewReader("roles: ["), new(bytes.Buffer))
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+		Describe("store", func() {
+			Context("no database", func() {
+				It("should keep it in memory", func() {
+					store, err := NewStore("")
+					Expect(err).To(BeNil())
+					defer store.Close()
+
+					_, err = store.AddNewspaper("times")
+					Expect(err).To(BeNil())
+				})
+			})
+			Context("unopenable database", func() {
+				It("should error", func() {
+					_, err := NewStore("/nonexistent/domain.db")
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+		Describe("graph", func() {
+			Context("policy with a cycle", func() {
+				It("should render it", func() {
+					out := new(bytes.Buffer)
+					err := RenderGraph(strings.NewReader(`
+roles:
+  a:
+    parents:
+    - b
+  b:
+    parents:
+    - a`), out, "mermaid")
+					Expect(err).To(BeNil())
+					Expect(out.String()).To(HavePrefix("graph BT"))
+					Expect(out.String()).To(ContainSubstring("class r0 cycle"))
+				})
+			})
+			Context("unknown format", func() {
+				It("should error", func() {
+					err := RenderGraph(strings.NewReader("roles: {}"), new(bytes.Buffer), "png")
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+		Describe("check", func() {
+			Context("policy missing permissions", func() {
+				It("should report them", func() {
+					schema := generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}}).Schema()
+
+					out := new(bytes.Buffer)
+					ok, err := CheckSchema(schema, &dummy.Dummy{}, out)
+					Expect(err).To(BeNil())
+					Expect(ok).To(BeFalse())
+					Expect(out.String()).To(ContainSubstring("no role grants Mutation.save needs rbac-mutate"))
+				})
+			})
+			Context("misused directive", func() {
+				It("should error whatever the policy", func() {
+					schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Input: `
+enum RBAC { MOD_PHOTO }
+directive @MaskUnlessRbac(rbac: RBAC!, replacement: String, domainField: String) on FIELD_DEFINITION
+type Query {
+  filename: String! @MaskUnlessRbac(rbac: MOD_PHOTO)
+}`})
+					Expect(gqlErr).To(BeNil())
+
+					_, err := CheckSchema(schema, &dummy.Dummy{}, new(bytes.Buffer))
+					Expect(err).To(MatchError("Invalid schema, Query.filename @MaskUnlessRbac is on a non null field without a replacement"))
+				})
+			})
+		})
 		Describe("options", func() {
 			Context("load from defaults", func() {
 				It("should use consts", func() {
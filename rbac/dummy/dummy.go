@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
 	"io"
+	"io/ioutil"
 )
 
+// Version is the policy version the dummy is always at
+const Version = 1
+
 type Dummy struct {
 }
 
@@ -21,15 +25,43 @@ func (d *Dummy) GetPermissions(name *string) ([]string, error) {
 
 func (d *Dummy) GetRoles(name *string) (map[string]types.Role, error) {
 	if name == nil {
-		return map[string]types.Role{"role1": {}, "role2": {}}, nil
+		return map[string]types.Role{"role1": {Version: Version}, "role2": {Version: Version}}, nil
 	}
 	if *name == "error" {
 		return nil, fmt.Errorf("Role error")
 	}
-	return map[string]types.Role{*name: {}}, nil
+	return map[string]types.Role{*name: {Version: Version}}, nil
+}
+
+func (d *Dummy) Version() int {
+	return Version
 }
 
-func (d *Dummy) UpsertRole(name *string, perms []*string, parents []*string) (types.Role, error) {
+func (d *Dummy) History() ([]types.Revision, error) {
+	return []types.Revision{{Version: Version, Change: "load"}}, nil
+}
+
+func (d *Dummy) Diff(from int, to int) (types.PolicyDiff, error) {
+	if from != Version || to != Version {
+		return types.PolicyDiff{}, fmt.Errorf("Diff error")
+	}
+	return types.PolicyDiff{}, nil
+}
+
+func (d *Dummy) Rollback(version int, m *types.Mutation) error {
+	if err := m.CheckVersion(Version); err != nil {
+		return err
+	}
+	if version != Version {
+		return fmt.Errorf("Rollback error")
+	}
+	return nil
+}
+
+func (d *Dummy) UpsertRole(name *string, perms []*string, parents []*string, m *types.Mutation) (types.Role, error) {
+	if err := m.CheckVersion(Version); err != nil {
+		return types.Role{}, err
+	}
 	if name == nil {
 		return types.Role{}, fmt.Errorf("Upsert error")
 	}
@@ -40,6 +72,7 @@ func (d *Dummy) UpsertRole(name *string, perms []*string, parents []*string) (ty
 	ret := types.Role{
 		Permissions: make([]string, 0),
 		Parents:     make([]string, 0),
+		Version:     Version,
 	}
 
 	for _, p := range perms {
@@ -59,7 +92,10 @@ func (d *Dummy) UpsertRole(name *string, perms []*string, parents []*string) (ty
 	return ret, nil
 }
 
-func (d *Dummy) DeleteRole(name *string) (bool, error) {
+func (d *Dummy) DeleteRole(name *string, m *types.Mutation) (bool, error) {
+	if err := m.CheckVersion(Version); err != nil {
+		return false, err
+	}
 	if name == nil {
 		return false, fmt.Errorf("Delete error")
 	}
@@ -69,7 +105,10 @@ func (d *Dummy) DeleteRole(name *string) (bool, error) {
 	return true, nil
 }
 
-func (d *Dummy) DeletePermission(name *string, permission *string) (bool, error) {
+func (d *Dummy) DeletePermission(name *string, permission *string, m *types.Mutation) (bool, error) {
+	if err := m.CheckVersion(Version); err != nil {
+		return false, err
+	}
 	if name == nil || permission == nil {
 		return false, fmt.Errorf("Delete error")
 	}
@@ -79,14 +118,89 @@ func (d *Dummy) DeletePermission(name *string, permission *string) (bool, error)
 	return true, nil
 }
 
+func (d *Dummy) ApplyChanges(changes []types.Change, m *types.Mutation) (map[string]types.Role, error) {
+	if err := m.CheckVersion(Version); err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]types.Role)
+	for _, c := range changes {
+		if c.Name == "error" {
+			return nil, fmt.Errorf("Apply error")
+		}
+		if c.Op == types.ChangeUpsertRole {
+			ret[c.Name] = types.Role{
+				Permissions: c.Permissions,
+				Parents:     c.Parents,
+				Domains:     c.Domains,
+				Version:     Version,
+			}
+		}
+	}
+	return ret, nil
+}
+
 func (d *Dummy) Load() error {
 	return nil
 }
 
-func (d *Dummy) Save(writer io.Writer) error {
+func (d *Dummy) Reload(reader io.Reader) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if string(data) == "error" {
+		return fmt.Errorf("Reload error")
+	}
+	return nil
+}
+
+func (d *Dummy) Restore(reader io.Reader, write func(fn func(writer io.Writer) error) error, m *types.Mutation) error {
+	if err := m.CheckVersion(Version); err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if string(data) == "error" {
+		return fmt.Errorf("Reload error")
+	}
+	return write(func(writer io.Writer) error {
+		_, err := writer.Write(data)
+		return err
+	})
+}
+
+func (d *Dummy) Save(writer io.Writer, m *types.Mutation) error {
+	if err := m.CheckVersion(Version); err != nil {
+		return err
+	}
 	return nil
 }
 
+func (d *Dummy) Export(writer io.Writer, format types.Format) error {
+	if format != types.FormatYAML && format != types.FormatJSON {
+		return fmt.Errorf("Export error")
+	}
+	_, err := fmt.Fprintf(writer, "version: %d\n", Version)
+	return err
+}
+
+func (d *Dummy) Import(reader io.Reader, mode types.ImportMode, dryRun bool, m *types.Mutation) (types.ImportResult, error) {
+	if err := m.CheckVersion(Version); err != nil {
+		return types.ImportResult{}, err
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return types.ImportResult{}, err
+	}
+	if string(data) == "error" {
+		return types.ImportResult{}, fmt.Errorf("Import error")
+	}
+	return types.ImportResult{DryRun: dryRun, Version: Version}, nil
+}
+
 func (d *Dummy) Check(roles []string, permission string) bool {
 	if permission == "error" {
 		return false
@@ -106,11 +220,86 @@ func (d *Dummy) Check(roles []string, permission string) bool {
 }
 
 func (d *Dummy) CheckDomain(roles []string, domain *string, permission string) bool {
-	if domain == nil {
+	if domain == nil || *domain == "" {
 		return false
 	}
 	if *domain == "error" {
 		return false
 	}
-	return d.Check(roles, fmt.Sprintf("%s-%s", *domain, permission))
+	return d.Check(roles, permission)
+}
+
+func (d *Dummy) EffectivePermissions(roles []string, domain *string) ([]string, error) {
+	if domain != nil && *domain == "error" {
+		return nil, fmt.Errorf("Domain error")
+	}
+	for _, r := range roles {
+		if r == "error" {
+			return nil, fmt.Errorf("Role error")
+		}
+	}
+	if len(roles) == 0 {
+		return []string{}, nil
+	}
+	return []string{"Perm1", "Perm2"}, nil
+}
+
+func (d *Dummy) RolesGranting(permission string, domain *string) ([]types.RoleGrant, error) {
+	if permission == "error" {
+		return nil, fmt.Errorf("Permission error")
+	}
+	return []types.RoleGrant{{Role: "role1", Path: []string{"role1"}}}, nil
+}
+
+func (d *Dummy) RoleGraph(writer io.Writer, format types.GraphFormat) error {
+	if format != types.GraphDOT && format != types.GraphMermaid && format != types.GraphJSON {
+		return fmt.Errorf("Graph error")
+	}
+	_, err := fmt.Fprintf(writer, "role1\nrole2\n")
+	return err
+}
+
+func (d *Dummy) Templates() (map[string]types.Role, error) {
+	return map[string]types.Role{
+		"{domain}-editor": {Permissions: []string{"{domain}-mod-story"}},
+	}, nil
+}
+
+func (d *Dummy) Tenants() ([]string, error) {
+	return []string{"tenant1"}, nil
+}
+
+func (d *Dummy) GetTenantRoles(tenant string, name *string) (map[string]types.Role, error) {
+	if tenant == "error" {
+		return nil, fmt.Errorf("Tenant error")
+	}
+	return d.GetRoles(name)
+}
+
+func (d *Dummy) GetTenantPermissions(tenant string) ([]string, error) {
+	if tenant == "error" {
+		return nil, fmt.Errorf("Tenant error")
+	}
+	return []string{"Perm3"}, nil
+}
+
+func (d *Dummy) UpsertTenantRole(tenant string, name *string, perms []*string, parents []*string, m *types.Mutation) (types.Role, error) {
+	if tenant == "error" {
+		return types.Role{}, fmt.Errorf("Upsert error")
+	}
+	return d.UpsertRole(name, perms, parents, m)
+}
+
+func (d *Dummy) DeleteTenantRole(tenant string, name *string, m *types.Mutation) (bool, error) {
+	if tenant == "error" {
+		return false, fmt.Errorf("Delete error")
+	}
+	return d.DeleteRole(name, m)
+}
+
+func (d *Dummy) CheckTenant(roles []string, tenant string, domain *string, permission string) bool {
+	if domain == nil || !types.InDomain(*domain, tenant) {
+		return false
+	}
+	return d.CheckDomain(roles, domain, permission)
 }
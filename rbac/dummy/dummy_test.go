@@ -5,7 +5,7 @@ import (
 	// "github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	// "strings"
+	"strings"
 )
 
 var _ = Describe("Rbac", func() {
@@ -34,6 +34,19 @@ var _ = Describe("Rbac", func() {
 				Expect(rbac.Check([]string{"editor", "photographer"}, "error")).To(BeFalse())
 			})
 		})
+		Context("Effective permissions", func() {
+			It("should succeed", func() {
+				ret, err := rbac.EffectivePermissions([]string{"editor"}, nil)
+				Expect(err).To(BeNil())
+				Expect(len(ret)).To(Equal(2))
+			})
+		})
+		Context("Effective permissions of an invalid role", func() {
+			It("should fail", func() {
+				_, err := rbac.EffectivePermissions([]string{"error"}, nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 		Context("Get all roles", func() {
 			It("should succeed", func() {
 				ret, err := rbac.GetRoles(nil)
@@ -86,7 +99,7 @@ var _ = Describe("Rbac", func() {
 				perms := []*string{&p}
 				parents := []*string{&pa}
 
-				new, err := rbac.UpsertRole(&r, perms, parents)
+				new, err := rbac.UpsertRole(&r, perms, parents, nil)
 				Expect(err).To(BeNil())
 				Expect(len(new.Parents)).To(Equal(1))
 				Expect(len(new.Permissions)).To(Equal(1))
@@ -100,7 +113,7 @@ var _ = Describe("Rbac", func() {
 				perms := []*string{&p}
 				parents := []*string{&pa}
 
-				_, err := rbac.UpsertRole(&r, perms, parents)
+				_, err := rbac.UpsertRole(&r, perms, parents, nil)
 				Expect(err).To(HaveOccurred())
 
 			})
@@ -109,7 +122,7 @@ var _ = Describe("Rbac", func() {
 			It("should succeed", func() {
 				r := "new"
 
-				ok, err := rbac.DeleteRole(&r)
+				ok, err := rbac.DeleteRole(&r, nil)
 				Expect(err).To(BeNil())
 				Expect(ok).To(BeTrue())
 			})
@@ -118,7 +131,7 @@ var _ = Describe("Rbac", func() {
 			It("should fail", func() {
 				r := "error"
 
-				_, err := rbac.DeleteRole(&r)
+				_, err := rbac.DeleteRole(&r, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -127,7 +140,7 @@ var _ = Describe("Rbac", func() {
 				r := "editor"
 				p := "add-text"
 
-				ok, err := rbac.DeletePermission(&r, &p)
+				ok, err := rbac.DeletePermission(&r, &p, nil)
 				Expect(err).To(BeNil())
 				Expect(ok).To(BeTrue())
 			})
@@ -137,7 +150,7 @@ var _ = Describe("Rbac", func() {
 				r := "error"
 				p := "new1"
 
-				_, err := rbac.DeletePermission(&r, &p)
+				_, err := rbac.DeletePermission(&r, &p, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -147,18 +160,28 @@ var _ = Describe("Rbac", func() {
 				r := "editor"
 				p := "error"
 
-				_, err := rbac.DeletePermission(&r, &p)
+				_, err := rbac.DeletePermission(&r, &p, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
 		Context("Can write yaml", func() {
 			It("should succeed", func() {
 				buf := new(bytes.Buffer)
-				rbac.Save(buf)
+				rbac.Save(buf, nil)
 
 				Expect(err).To(BeNil())
 			})
 		})
+		Context("Can reload", func() {
+			It("should succeed", func() {
+				Expect(rbac.Reload(strings.NewReader("roles: {}"))).To(BeNil())
+			})
+		})
+		Context("Reload error", func() {
+			It("should fail", func() {
+				Expect(rbac.Reload(strings.NewReader("error"))).To(HaveOccurred())
+			})
+		})
 	})
 
 })
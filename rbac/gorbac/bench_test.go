@@ -0,0 +1,74 @@
+package gorbac
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// deepPolicy is a chain of depth roles, only the last grants the permission
+// and each role in the chain also has width siblings as parents to search
+func deepPolicy(b *testing.B, depth int, width int) *Rbac {
+	var yaml strings.Builder
+	yaml.WriteString("permissions:\n- add-text\n- add-photo\nroles:\n")
+
+	for i := 0; i < depth; i++ {
+		fmt.Fprintf(&yaml, " level-%d:\n  permissions:\n", i)
+		if i == depth-1 {
+			yaml.WriteString("  - add-text\n")
+		}
+		yaml.WriteString("  parents:\n")
+		if i < depth-1 {
+			fmt.Fprintf(&yaml, "  - level-%d\n", i+1)
+		}
+		for j := 0; j < width; j++ {
+			fmt.Fprintf(&yaml, "  - sibling-%d-%d\n", i, j)
+		}
+		for j := 0; j < width; j++ {
+			fmt.Fprintf(&yaml, " sibling-%d-%d:\n  permissions:\n  - add-photo\n", i, j)
+		}
+	}
+
+	rbac, err := NewRbac(strings.NewReader(yaml.String()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	return rbac
+}
+
+func benchmarkCheck(b *testing.B, depth int, cached bool) {
+	rbac := deepPolicy(b, depth, 3)
+	roles := []string{"level-0"}
+	key := newDecision(roles, nil, "AddText")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var granted bool
+		if cached {
+			granted = rbac.Check(roles, "AddText")
+		} else {
+			granted = rbac.snapshot().granted(roles, key)
+		}
+		if !granted {
+			b.Fatal("not granted")
+		}
+	}
+}
+
+func BenchmarkCheckDepth5(b *testing.B)          { benchmarkCheck(b, 5, true) }
+func BenchmarkCheckDepth5Uncached(b *testing.B)  { benchmarkCheck(b, 5, false) }
+func BenchmarkCheckDepth20(b *testing.B)         { benchmarkCheck(b, 20, true) }
+func BenchmarkCheckDepth20Uncached(b *testing.B) { benchmarkCheck(b, 20, false) }
+func BenchmarkCheckDepth50(b *testing.B)         { benchmarkCheck(b, 50, true) }
+func BenchmarkCheckDepth50Uncached(b *testing.B) { benchmarkCheck(b, 50, false) }
+
+func BenchmarkCheckParallel(b *testing.B) {
+	rbac := deepPolicy(b, 20, 3)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			rbac.Check([]string{"level-0"}, "AddText")
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package gorbac
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/mikespook/gorbac"
+)
+
+// DecisionCacheSize is how many check results each version of the policy remembers
+// A new version starts with an empty cache so results never outlive the policy they came from
+const DecisionCacheSize = 4096
+
+// decision is the cache key, roles is the sorted role set so the order they were asked in doesn't matter
+// Role names come from the token so each is quoted, no name can then make the key of another role set
+// hasDomain keeps CheckDomain apart from Check, which has no domain
+type decision struct {
+	roles      string
+	domain     string
+	hasDomain  bool
+	permission string
+	// tenant is set for CheckTenant, whose domain is always within the tenant so isn't kept
+	tenant string
+}
+
+func newDecision(roles []string, domain *string, permission string) decision {
+	key := decision{
+		permission: permission,
+	}
+	if domain != nil {
+		key.domain = *domain
+		key.hasDomain = true
+	}
+
+	switch len(roles) {
+	case 0:
+	case 1:
+		key.roles = strconv.Quote(roles[0])
+	default:
+		sorted := make([]string, len(roles))
+		for i, role := range roles {
+			sorted[i] = strconv.Quote(role)
+		}
+		sort.Strings(sorted)
+		key.roles = strings.Join(sorted, ",")
+	}
+
+	return key
+}
+
+// check answers from the cache if it can
+func (s *snapshot) check(roles []string, domain *string, permission string) bool {
+	key := newDecision(roles, domain, permission)
+	if granted, ok := s.decisions.Get(key); ok {
+		return granted.(bool)
+	}
+
+	granted := s.granted(roles, key)
+	s.decisions.Add(key, granted)
+	return granted
+}
+
+// checkTenant answers from the cache if it can
+func (s *snapshot) checkTenant(roles []string, tenant string, permission string) bool {
+	key := newDecision(roles, nil, permission)
+	key.tenant = tenant
+	if granted, ok := s.decisions.Get(key); ok {
+		return granted.(bool)
+	}
+
+	granted := false
+	if graph, ok := s.tenants[tenant]; ok {
+		p := gorbac.NewStdPermission(key.pid())
+		for _, role := range roles {
+			if graph.IsGranted(role, p, nil) {
+				granted = true
+				break
+			}
+		}
+	}
+	s.decisions.Add(key, granted)
+	return granted
+}
+
+// pid is the permission as it is in the policy
+func (d decision) pid() string {
+	return strcase.ToKebab(d.permission)
+}
+
+// granted walks the role hierarchy
+func (s *snapshot) granted(roles []string, key decision) bool {
+	p, ok := s.permissions[key.pid()]
+	if !ok {
+		return false
+	}
+	if key.hasDomain {
+		p = newDomainPermission(key.domain, p.ID())
+	}
+	for _, role := range roles {
+		if s.rbac.IsGranted(role, p, nil) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package gorbac
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+permissions:
+- add-text
+- mod-story
+roles:
+ editor:
+  permissions:
+  - add-text
+  domains:
+   times:
+   - mod-story
+ chief:
+  parents:
+  - editor`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Repeated checks", func() {
+		It("should be answered from the cache", func() {
+			Expect(rbac.Check([]string{"chief", "nobody"}, "AddText")).To(BeTrue())
+			Expect(rbac.snapshot().decisions.Len()).To(Equal(1))
+
+			// the same role set in a different order is the same decision
+			Expect(rbac.Check([]string{"nobody", "chief"}, "AddText")).To(BeTrue())
+			Expect(rbac.snapshot().decisions.Len()).To(Equal(1))
+		})
+		It("should keep domains apart", func() {
+			times, mail := "times", "mail"
+			Expect(rbac.CheckDomain([]string{"chief"}, &times, "ModStory")).To(BeTrue())
+			Expect(rbac.CheckDomain([]string{"chief"}, &mail, "ModStory")).To(BeFalse())
+			Expect(rbac.snapshot().decisions.Len()).To(Equal(2))
+		})
+		It("should not mistake one role for a set of them", func() {
+			Expect(rbac.Check([]string{"editor", "nobody"}, "AddText")).To(BeTrue())
+
+			// role names come from the token so could be anything
+			for _, role := range []string{"editor\nnobody", "nobody\neditor", `"editor","nobody"`, `editor","nobody`} {
+				Expect(rbac.Check([]string{role}, "AddText")).To(BeFalse(), role)
+			}
+			Expect(rbac.Check([]string{`"editor"`}, "AddText")).To(BeFalse())
+			Expect(rbac.Check([]string{"editor", ""}, "AddText")).To(BeTrue())
+			Expect(rbac.Check([]string{"", "editor,"}, "AddText")).To(BeFalse())
+		})
+		It("should be bounded", func() {
+			for i := 0; i < DecisionCacheSize*2; i++ {
+				rbac.Check([]string{"chief"}, fmt.Sprintf("perm-%d", i))
+			}
+			Expect(rbac.snapshot().decisions.Len()).To(Equal(DecisionCacheSize))
+		})
+	})
+	Context("Policy changes", func() {
+		It("should not see decisions from the old version", func() {
+			Expect(rbac.Check([]string{"chief"}, "add-text")).To(BeTrue())
+
+			r := "editor"
+			p := "add-text"
+			_, err := rbac.DeletePermission(&r, &p, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rbac.Check([]string{"chief"}, "add-text")).To(BeFalse())
+		})
+		It("should start each version empty", func() {
+			Expect(rbac.Check([]string{"editor"}, "add-text")).To(BeTrue())
+
+			Expect(rbac.Reload(strings.NewReader(`
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text`))).To(BeNil())
+			Expect(rbac.snapshot().decisions.Len()).To(Equal(0))
+		})
+	})
+})
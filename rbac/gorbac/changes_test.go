@@ -0,0 +1,123 @@
+package gorbac
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApplyChanges", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+version: 1
+permissions:
+- add-text
+- add-photo
+roles:
+ editor:
+  permissions:
+  - add-text
+ photographer:
+  permissions:
+  - add-photo`))
+		Expect(err).To(BeNil())
+	})
+
+	unchanged := func() {
+		Expect(rbac.Version()).To(Equal(1))
+		roles, err := rbac.GetRoles(nil)
+		Expect(err).To(BeNil())
+		Expect(roles).To(HaveLen(2))
+		Expect(roles["editor"].Permissions).To(Equal([]string{"add-text"}))
+	}
+
+	Context("Valid batch", func() {
+		It("should apply every change as one version", func() {
+			roles, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "chief", Permissions: []string{"del-text"}},
+				{Op: types.ChangeUpsertRole, Name: "editor", Parents: []string{"chief"}},
+				{Op: types.ChangeDeletePermission, Name: "editor", Permission: "add-text"},
+				{Op: types.ChangeDeleteRole, Name: "photographer"},
+			}, &types.Mutation{User: "alice"})
+			Expect(err).To(BeNil())
+
+			Expect(roles).To(HaveLen(2))
+			Expect(roles["chief"].Permissions).To(Equal([]string{"del-text"}))
+			Expect(roles["editor"].Permissions).To(BeEmpty())
+			Expect(roles["editor"].Parents).To(Equal([]string{"chief"}))
+			Expect(roles["editor"].Version).To(Equal(2))
+
+			Expect(rbac.Version()).To(Equal(2))
+			history, _ := rbac.History()
+			Expect(history[0].Change).To(Equal("apply 4 changes"))
+			Expect(history[0].User).To(Equal("alice"))
+		})
+	})
+	Context("Parent created later in the batch", func() {
+		It("should fail as changes apply in order", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "editor", Parents: []string{"chief"}},
+				{Op: types.ChangeUpsertRole, Name: "chief", Permissions: []string{"del-text"}},
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			unchanged()
+		})
+	})
+	Context("Failing change", func() {
+		It("should apply none of them", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "chief", Permissions: []string{"del-text"}},
+				{Op: types.ChangeDeletePermission, Name: "editor", Permission: "add-text"},
+				{Op: types.ChangeDeleteRole, Name: "invalid"},
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			unchanged()
+		})
+	})
+	Context("Batch creating a cycle", func() {
+		It("should apply none of them", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "editor", Parents: []string{"photographer"}},
+				{Op: types.ChangeUpsertRole, Name: "photographer", Parents: []string{"editor"}},
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			unchanged()
+		})
+	})
+	Context("Batch leaving a dangling parent", func() {
+		It("should apply none of them", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "editor", Parents: []string{"photographer"}},
+				{Op: types.ChangeDeleteRole, Name: "photographer"},
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			unchanged()
+		})
+	})
+	Context("Unknown change", func() {
+		It("should fail", func() {
+			_, err := rbac.ApplyChanges([]types.Change{{Op: "invalid", Name: "editor"}}, nil)
+			Expect(err).To(HaveOccurred())
+			unchanged()
+		})
+	})
+	Context("Stale version", func() {
+		It("should conflict", func() {
+			v := 0
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeDeleteRole, Name: "photographer"},
+			}, &types.Mutation{ExpectedVersion: &v})
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+			unchanged()
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package gorbac
+
+import (
+	"strconv"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	"github.com/mikespook/gorbac"
+)
+
+// domainPermission is a permission within a domain, the domain and permission are kept apart
+// so no domain and permission pair can be mistaken for another
+type domainPermission struct {
+	domain     string
+	permission string
+}
+
+func newDomainPermission(domain string, permission string) gorbac.Permission {
+	return &domainPermission{
+		domain:     domain,
+		permission: permission,
+	}
+}
+
+// ID is unique to the pair, quoting means it can't clash with another pair or a plain permission
+func (p *domainPermission) ID() string {
+	return strconv.Quote(p.domain) + ":" + p.permission
+}
+
+// Match is true for the same permission in the same domain or one below it, or any domain if granted with AnyDomain
+func (p *domainPermission) Match(a gorbac.Permission) bool {
+	q, ok := a.(*domainPermission)
+	if !ok {
+		return false
+	}
+	return p.permission == q.permission && (p.domain == types.AnyDomain || types.InDomain(q.domain, p.domain))
+}
+
+// grantedIn is true if the role itself grants the permission in the domain, one above it or AnyDomain
+func grantedIn(role types.Role, domain string, permission string) bool {
+	for _, d := range append(types.DomainPath(domain), types.AnyDomain) {
+		for _, p := range role.Domains[d] {
+			if p == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package gorbac
+
+import (
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domain", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+permissions:
+- mod-story
+- bugle-mod-story
+- del-media
+roles:
+ bugle-editor:
+  domains:
+   the-bugle:
+   - mod-story
+ the-editor:
+  domains:
+   the:
+   - bugle-mod-story
+ admin:
+  domains:
+   "*":
+   - del-media`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Hyphenated domains", func() {
+		It("should not be mistaken for one another", func() {
+			the, bugle := "the", "the-bugle"
+			Expect(rbac.CheckDomain([]string{"bugle-editor"}, &bugle, "ModStory")).To(BeTrue())
+			Expect(rbac.CheckDomain([]string{"bugle-editor"}, &the, "BugleModStory")).To(BeFalse())
+			Expect(rbac.CheckDomain([]string{"the-editor"}, &the, "BugleModStory")).To(BeTrue())
+			Expect(rbac.CheckDomain([]string{"the-editor"}, &bugle, "ModStory")).To(BeFalse())
+		})
+		It("should not pass Check", func() {
+			Expect(rbac.Check([]string{"bugle-editor"}, "ModStory")).To(BeFalse())
+		})
+	})
+
+	Context("Empty domain", func() {
+		It("should grant nothing", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "media", Permissions: []string{"del-media"}},
+			}, nil)
+			Expect(err).To(BeNil())
+
+			empty := ""
+			// asked both ways so the cached Check answer can't be reused for the domain
+			Expect(rbac.Check([]string{"media"}, "DEL_MEDIA")).To(BeTrue())
+			Expect(rbac.CheckDomain([]string{"media"}, &empty, "DEL_MEDIA")).To(BeFalse())
+			Expect(rbac.CheckDomain([]string{"admin"}, &empty, "DEL_MEDIA")).To(BeFalse())
+
+			Expect(rbac.EffectivePermissions([]string{"admin"}, &empty)).To(BeEmpty())
+			Expect(rbac.RolesGranting("DEL_MEDIA", &empty)).To(BeEmpty())
+		})
+	})
+
+	Context("Any domain", func() {
+		It("should grant in every domain", func() {
+			for _, d := range []string{"the", "the-bugle", "times"} {
+				domain := d
+				Expect(rbac.CheckDomain([]string{"admin"}, &domain, "DelMedia")).To(BeTrue())
+			}
+			Expect(rbac.Check([]string{"admin"}, "DelMedia")).To(BeFalse())
+		})
+	})
+
+	Context("Hierarchical domains", func() {
+		BeforeEach(func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "acme-editor", Domains: map[string][]string{"acme": {"mod-story"}}},
+				{Op: types.ChangeUpsertRole, Name: "sport-editor", Domains: map[string][]string{"acme/the-bugle/sport": {"mod-story"}}},
+			}, nil)
+			Expect(err).To(BeNil())
+		})
+		It("should inherit down the path", func() {
+			for _, d := range []string{"acme", "acme/the-bugle", "acme/the-bugle/sport"} {
+				domain := d
+				Expect(rbac.CheckDomain([]string{"acme-editor"}, &domain, "ModStory")).To(BeTrue())
+			}
+		})
+		It("should not inherit up or across", func() {
+			for _, d := range []string{"acme/the-bugle", "acme", "acme/the-bugle/news", "acme-corp"} {
+				domain := d
+				Expect(rbac.CheckDomain([]string{"sport-editor"}, &domain, "ModStory")).To(BeFalse())
+			}
+			other := "acmecorp/the-bugle"
+			Expect(rbac.CheckDomain([]string{"acme-editor"}, &other, "ModStory")).To(BeFalse())
+		})
+		It("should be effective and found below", func() {
+			domain := "acme/the-bugle"
+			perms, err := rbac.EffectivePermissions([]string{"acme-editor"}, &domain)
+			Expect(err).To(BeNil())
+			Expect(perms).To(Equal([]string{"mod-story"}))
+
+			grants, err := rbac.RolesGranting("ModStory", &domain)
+			Expect(err).To(BeNil())
+			Expect(grants).To(Equal([]types.RoleGrant{{Role: "acme-editor", Path: []string{"acme-editor"}}}))
+		})
+	})
+
+	Context("Changes", func() {
+		It("should add grants", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "the-editor", Domains: map[string][]string{"times": {"mod-story"}}},
+			}, nil)
+			Expect(err).To(BeNil())
+
+			times := "times"
+			Expect(rbac.CheckDomain([]string{"the-editor"}, &times, "ModStory")).To(BeTrue())
+
+			diff, err := rbac.Diff(rbac.Version()-1, rbac.Version())
+			Expect(err).To(BeNil())
+			Expect(diff.ChangedRoles[0].AddedGrants).To(Equal([]types.Grant{{Domain: "times", Permission: "mod-story"}}))
+		})
+		It("should remove a grant in one domain", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeDeletePermission, Name: "bugle-editor", Domain: "the-bugle", Permission: "mod-story"},
+			}, nil)
+			Expect(err).To(BeNil())
+
+			bugle := "the-bugle"
+			Expect(rbac.CheckDomain([]string{"bugle-editor"}, &bugle, "ModStory")).To(BeFalse())
+		})
+		It("should fail removing a grant the role doesn't have", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeDeletePermission, Name: "bugle-editor", Domain: "the", Permission: "mod-story"},
+			}, nil)
+			Expect(err).To(MatchError("Permission mod-story in the not found"))
+		})
+	})
+
+	Context("Validation", func() {
+		It("should reject undeclared permissions", func() {
+			_, err := NewRbac(strings.NewReader(`
+roles:
+ editor:
+  domains:
+   times:
+   - mod-story`))
+			Expect(err).To(MatchError("Invalid policy, role editor has permission mod-story in times which isn't in permissions"))
+		})
+		It("should reject a domain with no name", func() {
+			_, err := NewRbac(strings.NewReader(`
+permissions:
+- mod-story
+roles:
+ editor:
+  domains:
+   "":
+   - mod-story`))
+			Expect(err).To(MatchError("Invalid policy, role editor grants permissions in a domain with no name"))
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package gorbac
+
+import (
+	"sort"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+)
+
+// EffectivePermissions is every permission the roles have, including those inherited from their parents
+// With a domain it is the permissions granted in that domain, those above it or AnyDomain, as CheckDomain is asked for them
+// Roles not in the policy grant nothing, as with Check, and nothing is granted in an empty domain
+func (r *Rbac) EffectivePermissions(roles []string, domain *string) ([]string, error) {
+	policy := r.policy()
+
+	ret := make([]string, 0)
+	if domain != nil && *domain == "" {
+		return ret, nil
+	}
+	for _, role := range roles {
+		if domain == nil {
+			for _, pid := range grants(policy, role) {
+				ret = appendIfMissing(ret, &pid)
+			}
+			continue
+		}
+		for _, name := range ancestors(policy, role) {
+			for _, d := range append(types.DomainPath(*domain), types.AnyDomain) {
+				for _, pid := range policy.Roles[name].Domains[d] {
+					ret = appendIfMissing(ret, &pid)
+				}
+			}
+		}
+	}
+
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// RolesGranting is every role with the permission, sorted, with the shortest inheritance path to where it is granted
+// The permission is named as it would be to Check or CheckDomain
+func (r *Rbac) RolesGranting(permission string, domain *string) ([]types.RoleGrant, error) {
+	policy := r.policy()
+	pid := newDecision(nil, domain, permission).pid()
+
+	grants := func(role types.Role) bool {
+		for _, p := range role.Permissions {
+			if p == pid {
+				return true
+			}
+		}
+		return false
+	}
+	if domain != nil {
+		grants = func(role types.Role) bool {
+			return *domain != "" && grantedIn(role, *domain, pid)
+		}
+	}
+
+	ret := make([]types.RoleGrant, 0)
+	for _, name := range roleNames(policy) {
+		if path := grantPath(policy, name, grants); path != nil {
+			ret = append(ret, types.RoleGrant{
+				Role: name,
+				Path: path,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// grantPath searches breadth first up through the parents so the path is the shortest, nil if not granted
+func grantPath(policy *Serialize, name string, grants func(types.Role) bool) []string {
+	from := map[string]string{name: ""}
+	queue := []string{name}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		if grants(policy.Roles[n]) {
+			path := []string{n}
+			for n != name {
+				n = from[n]
+				path = append([]string{n}, path...)
+			}
+			return path
+		}
+
+		for _, parent := range policy.Roles[n].Parents {
+			if _, seen := from[parent]; !seen {
+				from[parent] = n
+				queue = append(queue, parent)
+			}
+		}
+	}
+
+	return nil
+}
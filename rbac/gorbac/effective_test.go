@@ -0,0 +1,102 @@
+package gorbac
+
+import (
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Effective", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+permissions:
+- add-text
+- mod-story
+- del-media
+roles:
+ editor:
+  permissions:
+  - add-text
+  domains:
+   times:
+   - mod-story
+ chief:
+  domains:
+   mail:
+   - mod-story
+   "*":
+   - del-media
+  parents:
+  - editor`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Permissions", func() {
+		It("should include inherited ones", func() {
+			perms, err := rbac.EffectivePermissions([]string{"chief"}, nil)
+			Expect(err).To(BeNil())
+			Expect(perms).To(Equal([]string{"add-text"}))
+		})
+		It("should be the union over roles", func() {
+			perms, err := rbac.EffectivePermissions([]string{"editor", "nobody"}, nil)
+			Expect(err).To(BeNil())
+			Expect(perms).To(Equal([]string{"add-text"}))
+		})
+		It("should be empty without roles", func() {
+			perms, err := rbac.EffectivePermissions(nil, nil)
+			Expect(err).To(BeNil())
+			Expect(perms).To(BeEmpty())
+		})
+	})
+	Context("Domain", func() {
+		It("should only have the domain's permissions, as CheckDomain asks for them", func() {
+			times := "times"
+			perms, err := rbac.EffectivePermissions([]string{"chief"}, &times)
+			Expect(err).To(BeNil())
+			Expect(perms).To(Equal([]string{"del-media", "mod-story"}))
+
+			for _, p := range perms {
+				Expect(rbac.CheckDomain([]string{"chief"}, &times, p)).To(BeTrue())
+			}
+		})
+	})
+	Context("Roles granting", func() {
+		It("should find direct and inherited grants with the path", func() {
+			grants, err := rbac.RolesGranting("AddText", nil)
+			Expect(err).To(BeNil())
+			Expect(grants).To(Equal([]types.RoleGrant{
+				{Role: "chief", Path: []string{"chief", "editor"}},
+				{Role: "editor", Path: []string{"editor"}},
+			}))
+		})
+		It("should look in the domain as CheckDomain does", func() {
+			mail := "mail"
+			grants, err := rbac.RolesGranting("ModStory", &mail)
+			Expect(err).To(BeNil())
+			Expect(grants).To(Equal([]types.RoleGrant{
+				{Role: "chief", Path: []string{"chief"}},
+			}))
+		})
+		It("should include roles granting every domain", func() {
+			times := "times"
+			grants, err := rbac.RolesGranting("DelMedia", &times)
+			Expect(err).To(BeNil())
+			Expect(grants).To(Equal([]types.RoleGrant{
+				{Role: "chief", Path: []string{"chief"}},
+			}))
+		})
+		It("should be empty for a permission nobody has", func() {
+			grants, err := rbac.RolesGranting("invalid", nil)
+			Expect(err).To(BeNil())
+			Expect(grants).To(BeEmpty())
+		})
+	})
+})
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"sort"
 	"sync"
+	"sync/atomic"
 
 	"gopkg.in/yaml.v2"
 
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
-	"github.com/iancoleman/strcase"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/mikespook/gorbac"
 )
 
@@ -26,76 +28,240 @@ func SaveYaml(writer io.Writer, v interface{}) error {
 }
 
 type Serialize struct {
-	Permissions []string              `yaml:"permissions"`
-	Roles       map[string]types.Role `yaml:"roles"`
+	Version     int                   `yaml:"version" json:"version"`
+	Permissions []string              `yaml:"permissions" json:"permissions"`
+	Roles       map[string]types.Role `yaml:"roles" json:"roles"`
+	// Templates are the roles each new domain gets, see types.ProvisionChanges
+	Templates map[string]types.Role `yaml:"templates,omitempty" json:"templates,omitempty"`
+	// Tenants are each tenant's own roles, see Tenant
+	Tenants map[string]Tenant `yaml:"tenants,omitempty" json:"tenants,omitempty"`
 }
 
-type Rbac struct {
+// snapshot is one version of the policy and the graph built from it
+// It is never changed once stored so readers need no lock
+type snapshot struct {
+	policy      *Serialize
 	rbac        *gorbac.RBAC
-	permissions *gorbac.Permissions
-	yamlAll     *Serialize
-	mutex       *sync.Mutex
+	permissions gorbac.Permissions
+	decisions   *lru.Cache
+	// tenants are the graphs of each tenant's roles
+	tenants map[string]*gorbac.RBAC
 }
 
-func NewRbac(reader io.Reader) (*Rbac, error) {
+type Rbac struct {
+	// current holds the *snapshot checks are made against
+	current atomic.Value
+	// mutex serialises writers, readers only load current
+	mutex   *sync.Mutex
+	history []revision
+}
 
-	ret := &Rbac{
-		yamlAll: &Serialize{},
-		mutex:   &sync.Mutex{},
+func NewRbac(reader io.Reader) (*Rbac, error) {
+	policy := &Serialize{}
+	if err := LoadYaml(reader, policy); err != nil {
+		return nil, err
 	}
 
-	if err := LoadYaml(reader, ret.yamlAll); err != nil {
+	s, err := newSnapshot(policy)
+	if err != nil {
 		return nil, err
 	}
 
-	err := ret.Load()
-	return ret, err
+	ret := &Rbac{
+		mutex: &sync.Mutex{},
+	}
+	ret.current.Store(s)
+	ret.record(nil, "load")
+	return ret, nil
 }
 
-func (r *Rbac) Load() error {
-	r.rbac = gorbac.New()
-	r.permissions = &gorbac.Permissions{}
+// newSnapshot validates the policy and builds its graph, the policy mustn't be changed afterwards
+func newSnapshot(policy *Serialize) (*snapshot, error) {
+	if policy.Roles == nil {
+		policy.Roles = make(map[string]types.Role)
+	}
+
+	if errs := Errors(ValidatePolicy(policy, nil)); len(errs) > 0 {
+		return nil, fmt.Errorf("Invalid policy, %s", errs[0].Message)
+	}
+
+	decisions, err := lru.New(DecisionCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &snapshot{
+		policy:      policy,
+		rbac:        gorbac.New(),
+		permissions: gorbac.Permissions{},
+		decisions:   decisions,
+		tenants:     make(map[string]*gorbac.RBAC, len(policy.Tenants)),
+	}
 
-	for _, pid := range r.yamlAll.Permissions {
-		(*r.permissions)[pid] = gorbac.NewStdPermission(pid)
+	for _, pid := range policy.Permissions {
+		s.permissions[pid] = gorbac.NewStdPermission(pid)
 	}
 
-	for k, v := range r.yamlAll.Roles {
+	for k, v := range policy.Roles {
 		role := gorbac.NewStdRole(k)
 		for _, pid := range v.Permissions {
-			role.Assign((*r.permissions)[pid])
+			role.Assign(s.permissions[pid])
 		}
-		r.rbac.Add(role)
+		for _, g := range v.Grants() {
+			role.Assign(newDomainPermission(g.Domain, g.Permission))
+		}
+		s.rbac.Add(role)
 	}
 
-	for k, v := range r.yamlAll.Roles {
-		if err := r.rbac.SetParents(k, v.Parents); err != nil {
-			return err
+	for k, v := range policy.Roles {
+		if err := s.rbac.SetParents(k, v.Parents); err != nil {
+			return nil, err
 		}
 	}
 
+	for name, t := range policy.Tenants {
+		if s.tenants[name], err = newTenantGraph(t); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (r *Rbac) snapshot() *snapshot {
+	return r.current.Load().(*snapshot)
+}
+
+// policy is the current policy, it must not be changed
+func (r *Rbac) policy() *Serialize {
+	return r.snapshot().policy
+}
+
+// Load rebuilds the graph from the current policy
+func (r *Rbac) Load() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	s, err := newSnapshot(r.policy())
+	if err != nil {
+		return err
+	}
+
+	r.current.Store(s)
 	return nil
 }
 
-func (r *Rbac) Save(writer io.Writer) error {
+// Reload replaces the policy with the one read from reader
+// The current policy is kept if the new one can't be loaded
+func (r *Rbac) Reload(reader io.Reader) error {
+	policy := &Serialize{}
+	if err := LoadYaml(reader, policy); err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.replace(policy, nil, "reload")
+}
+
+// Restore replaces the policy with the one read from reader once write, which puts it on disk, succeeds
+// What is written has the new version so it can't go backwards on the next load
+// The current policy is kept if the new one can't be loaded, isn't replacing the expected version or write fails
+func (r *Rbac) Restore(reader io.Reader, write func(fn func(writer io.Writer) error) error, m *types.Mutation) error {
+	policy := &Serialize{}
+	if err := LoadYaml(reader, policy); err != nil {
+		return err
+	}
 
-	// remove any permissions not mentioned in roles
-	r.yamlAll.Permissions = make([]string, 0)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 
-	for _, v := range r.yamlAll.Roles {
-		for _, pid := range v.Permissions {
-			r.yamlAll.Permissions = appendIfMissing(r.yamlAll.Permissions, &pid)
-		}
+	if err := m.CheckVersion(r.policy().Version); err != nil {
+		return err
 	}
 
-	err := SaveYaml(writer, r.yamlAll)
+	s, err := r.stage(policy)
+	if err != nil {
+		return err
+	}
+	err = write(func(writer io.Writer) error {
+		return SaveYaml(writer, s.policy)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.commit(s, m, "restore")
+	return nil
+}
+
+// replace makes a new policy current if it loads, it must be called with the mutex held
+// The policy mustn't be shared as it becomes part of the snapshot
+func (r *Rbac) replace(policy *Serialize, m *types.Mutation, change string) error {
+	s, err := r.stage(policy)
 	if err != nil {
 		return err
 	}
 
-	err = r.Load()
+	r.commit(s, m, change)
+	return nil
+}
+
+// stage builds the snapshot of a new policy without making it current, it must be called with the mutex held
+func (r *Rbac) stage(policy *Serialize) (*snapshot, error) {
+	// never go backwards, a client holding an old version mustn't match the new policy
+	if current := r.policy().Version; policy.Version <= current {
+		policy.Version = current + 1
+	}
+
+	return newSnapshot(policy)
+}
 
-	return err
+// commit makes a staged snapshot current, it must be called with the mutex held
+func (r *Rbac) commit(s *snapshot, m *types.Mutation, change string) {
+	r.current.Store(s)
+	r.record(m, change)
+}
+
+// Save writes the current policy, if it is the version m expects
+// The mutex is held so the version can't change between the check and the write
+func (r *Rbac) Save(writer io.Writer, m *types.Mutation) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	current := r.policy()
+	if err := m.CheckVersion(current.Version); err != nil {
+		return err
+	}
+	policy := current.clone()
+
+	policy.Permissions = make([]string, 0)
+	for _, name := range roleNames(policy) {
+		for _, pid := range policy.Roles[name].Permissions {
+			policy.Permissions = appendIfMissing(policy.Permissions, &pid)
+		}
+		for _, g := range policy.Roles[name].Grants() {
+			policy.Permissions = appendIfMissing(policy.Permissions, &g.Permission)
+		}
+	}
+	// tenants can use the global permissions so they're kept if a tenant grants them
+	for _, t := range current.Tenants {
+		for _, role := range t.Roles {
+			for _, pid := range role.Permissions {
+				if contains(current.Permissions, pid) {
+					policy.Permissions = appendIfMissing(policy.Permissions, &pid)
+				}
+			}
+		}
+	}
+
+	// don't write out a policy that won't load
+	if errs := Errors(ValidatePolicy(policy, nil)); len(errs) > 0 {
+		return fmt.Errorf("Invalid policy, %s", errs[0].Message)
+	}
+
+	return SaveYaml(writer, policy)
 }
 
 func appendIfMissing(slice []string, i *string) []string {
@@ -107,41 +273,58 @@ func appendIfMissing(slice []string, i *string) []string {
 	return append(slice, *i)
 }
 func (r *Rbac) Check(roles []string, permission string) bool {
-
-	kebabPermission := strcase.ToKebab(permission)
-
-	for _, role := range roles {
-		if p, ok := (*r.permissions)[kebabPermission]; ok {
-			if r.rbac.IsGranted(role, p, nil) {
-				return true
-			}
-		}
-	}
-	return false
+	return r.snapshot().check(roles, nil, permission)
 }
 
+// CheckDomain checks the permissions granted in the domain, an empty domain is never granted anything
 func (r *Rbac) CheckDomain(roles []string, domain *string, permission string) bool {
-	if domain == nil {
+	if domain == nil || *domain == "" {
 		return false
 	}
-	return r.Check(roles, fmt.Sprintf("%s-%s", *domain, permission))
+	return r.snapshot().check(roles, domain, permission)
 }
 
 func (r *Rbac) GetRoles(name *string) (map[string]types.Role, error) {
+	all := r.policy()
 	if name == nil {
-		return r.yamlAll.Roles, nil
+		ret := make(map[string]types.Role, len(all.Roles))
+		for k := range all.Roles {
+			ret[k] = all.role(k)
+		}
+		return ret, nil
 	}
-	if role, ok := r.yamlAll.Roles[*name]; ok {
-		return map[string]types.Role{*name: role}, nil
+	if _, ok := all.Roles[*name]; ok {
+		return map[string]types.Role{*name: all.role(*name)}, nil
 	}
 	return nil, fmt.Errorf("Role %s not found", *name)
 }
 
+// role copies a role out of the policy so callers can't change a snapshot
+func (s *Serialize) role(name string) types.Role {
+	role := s.Roles[name].Clone()
+	role.Version = s.Version
+	return role
+}
+
+func (r *Rbac) Templates() (map[string]types.Role, error) {
+	policy := r.policy()
+	ret := make(map[string]types.Role, len(policy.Templates))
+	for name, t := range policy.Templates {
+		ret[name] = t.Clone()
+	}
+	return ret, nil
+}
+
+func (r *Rbac) Version() int {
+	return r.policy().Version
+}
+
 func (r *Rbac) GetPermissions(name *string) ([]string, error) {
+	permissions := r.policy().Permissions
 	if name == nil {
-		return r.yamlAll.Permissions, nil
+		return append([]string{}, permissions...), nil
 	}
-	for _, perm := range r.yamlAll.Permissions {
+	for _, perm := range permissions {
 		if perm == *name {
 			return []string{*name}, nil
 		}
@@ -149,74 +332,222 @@ func (r *Rbac) GetPermissions(name *string) ([]string, error) {
 	return nil, fmt.Errorf("Permission %s not found", *name)
 }
 
-func (r *Rbac) UpsertRole(name *string, perms []*string, parents []*string) (types.Role, error) {
-	r.mutex.Lock()
-	var role types.Role
-	var ok bool
-
-	if role, ok = r.yamlAll.Roles[*name]; !ok {
-		// not found so add it
-		role = types.Role{}
-		r.yamlAll.Roles[*name] = role
+func (r *Rbac) UpsertRole(name *string, perms []*string, parents []*string, m *types.Mutation) (types.Role, error) {
+	policy, err := r.apply([]types.Change{{
+		Op:          types.ChangeUpsertRole,
+		Name:        *name,
+		Permissions: deref(perms),
+		Parents:     deref(parents),
+	}}, m, fmt.Sprintf("upsert role %s", *name))
+	if err != nil {
+		return types.Role{}, err
 	}
 
-	for _, v := range perms {
-		role.Permissions = appendIfMissing(role.Permissions, v)
-		r.yamlAll.Permissions = appendIfMissing(r.yamlAll.Permissions, v)
-	}
+	return policy.role(*name), nil
+}
 
-	for _, v := range parents {
-		if _, err := r.GetRoles(v); err != nil {
-			r.mutex.Unlock()
-			return types.Role{}, fmt.Errorf("Parent role %s not found", *v)
-		}
+func (r *Rbac) DeleteRole(name *string, m *types.Mutation) (bool, error) {
+	_, err := r.apply([]types.Change{{
+		Op:   types.ChangeDeleteRole,
+		Name: *name,
+	}}, m, fmt.Sprintf("delete role %s", *name))
+	return err == nil, err
+}
 
-		role.Parents = appendIfMissing(role.Parents, v)
-	}
+func (r *Rbac) DeletePermission(name *string, permission *string, m *types.Mutation) (bool, error) {
+	_, err := r.apply([]types.Change{{
+		Op:         types.ChangeDeletePermission,
+		Name:       *name,
+		Permission: *permission,
+	}}, m, fmt.Sprintf("delete permission %s from role %s", *permission, *name))
+	return err == nil, err
+}
 
-	r.yamlAll.Roles[*name] = role
+// ApplyChanges applies the changes in order, either all of them apply or none do
+// It returns the roles the changes upserted or removed permissions from, tenants' roles are read with GetTenantRoles
+func (r *Rbac) ApplyChanges(changes []types.Change, m *types.Mutation) (map[string]types.Role, error) {
+	policy, err := r.apply(changes, m, fmt.Sprintf("apply %d changes", len(changes)))
+	if err != nil {
+		return nil, err
+	}
 
-	r.mutex.Unlock()
-	return role, nil
+	ret := make(map[string]types.Role)
+	for _, c := range changes {
+		if _, ok := policy.Roles[c.Name]; ok && c.Op != types.ChangeDeleteRole && c.Tenant == "" {
+			ret[c.Name] = policy.role(c.Name)
+		}
+	}
+	return ret, nil
 }
 
-func (r *Rbac) DeleteRole(name *string) (bool, error) {
+// apply makes the changes to a copy of the policy, which only replaces the current one
+// and the graph Check uses if every change succeeds and the result is valid
+func (r *Rbac) apply(changes []types.Change, m *types.Mutation, description string) (*Serialize, error) {
 	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	current := r.policy()
+	if err := m.CheckVersion(current.Version); err != nil {
+		return nil, err
+	}
 
-	if _, ok := r.yamlAll.Roles[*name]; !ok {
-		r.mutex.Unlock()
-		return false, fmt.Errorf("Role %s not found", *name)
+	policy := current.clone()
+	for _, c := range changes {
+		if err := applyChange(policy, c); err != nil {
+			return nil, err
+		}
 	}
 
-	delete(r.yamlAll.Roles, *name)
+	// replace validates, catching cycles and parents removed by a later change
+	policy.Version++
+	if err := r.replace(policy, m, description); err != nil {
+		return nil, err
+	}
 
-	r.mutex.Unlock()
-	return true, nil
+	return policy, nil
 }
 
-func (r *Rbac) DeletePermission(name *string, permission *string) (bool, error) {
-	r.mutex.Lock()
-	var role types.Role
-	var ok bool
+func applyChange(policy *Serialize, c types.Change) error {
+	if c.Tenant != "" {
+		return applyTenantChange(policy, c)
+	}
+
+	switch c.Op {
+	case types.ChangeUpsertRole:
+		for _, p := range c.Parents {
+			if _, ok := policy.Roles[p]; !ok {
+				return fmt.Errorf("Parent role %s not found", p)
+			}
+		}
 
-	if role, ok = r.yamlAll.Roles[*name]; !ok {
-		r.mutex.Unlock()
-		return false, fmt.Errorf("Role %s not found", *name)
+		// not found is an empty role so it gets added
+		role := policy.Roles[c.Name]
+
+		for i := range c.Permissions {
+			role.Permissions = appendIfMissing(role.Permissions, &c.Permissions[i])
+			policy.Permissions = appendIfMissing(policy.Permissions, &c.Permissions[i])
+		}
+
+		for i := range c.Parents {
+			role.Parents = appendIfMissing(role.Parents, &c.Parents[i])
+		}
+
+		for _, d := range sortedKeys(c.Domains) {
+			if role.Domains == nil {
+				role.Domains = make(map[string][]string)
+			}
+			perms := c.Domains[d]
+			for i := range perms {
+				role.Domains[d] = appendIfMissing(role.Domains[d], &perms[i])
+				policy.Permissions = appendIfMissing(policy.Permissions, &perms[i])
+			}
+		}
+
+		policy.Roles[c.Name] = role
+
+	case types.ChangeDeleteRole:
+		if _, ok := policy.Roles[c.Name]; !ok {
+			return fmt.Errorf("Role %s not found", c.Name)
+		}
+
+		delete(policy.Roles, c.Name)
+
+	case types.ChangeRemovePermission:
+		found := false
+		policy.Permissions, found = without(policy.Permissions, c.Permission)
+		for name, role := range policy.Roles {
+			var granted bool
+			role.Permissions, granted = without(role.Permissions, c.Permission)
+			for d := range role.Domains {
+				if withoutDomain(&role, d, c.Permission) {
+					granted = true
+				}
+			}
+			if granted {
+				policy.Roles[name] = role
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("Permission %s not found", c.Permission)
+		}
+
+	case types.ChangeDeletePermission:
+		role, ok := policy.Roles[c.Name]
+		if !ok {
+			return fmt.Errorf("Role %s not found", c.Name)
+		}
+
+		if c.Domain != "" {
+			if !withoutDomain(&role, c.Domain, c.Permission) {
+				return fmt.Errorf("Permission %s in %s not found", c.Permission, c.Domain)
+			}
+			policy.Roles[c.Name] = role
+			break
+		}
+
+		perms := make([]string, 0, len(role.Permissions))
+		for _, p := range role.Permissions {
+			if p != c.Permission {
+				perms = append(perms, p)
+			}
+		}
+		if len(perms) == len(role.Permissions) {
+			return fmt.Errorf("Permission %s not found", c.Permission)
+		}
+
+		role.Permissions = perms
+		policy.Roles[c.Name] = role
+
+	default:
+		return fmt.Errorf("Unknown change %s", c.Op)
 	}
 
-	perms := r.yamlAll.Roles[*name].Permissions
+	return nil
+}
 
-	for i, p := range perms {
-		if p == *permission {
-			perms = append(perms[:i], perms[i+1:]...)
-			role.Permissions = perms
-			r.yamlAll.Roles[*name] = role
-			r.mutex.Unlock()
-			return true, nil
+// without is slice less value, and whether value was in it
+func without(slice []string, value string) ([]string, bool) {
+	ret := make([]string, 0, len(slice))
+	for _, v := range slice {
+		if v != value {
+			ret = append(ret, v)
 		}
 	}
+	return ret, len(ret) != len(slice)
+}
 
-	r.mutex.Unlock()
-	return false, fmt.Errorf("Permission %s not found", *permission)
+// withoutDomain takes the permission from the role's grants in the domain, dropping the domain if none are left
+func withoutDomain(role *types.Role, domain string, permission string) bool {
+	perms, found := without(role.Domains[domain], permission)
+	if !found {
+		return false
+	}
+	if len(perms) == 0 {
+		delete(role.Domains, domain)
+	} else {
+		role.Domains[domain] = perms
+	}
+	return true
+}
 
+// sortedKeys is the domains in order, so changes are applied the same way each time
+func sortedKeys(domains map[string][]string) []string {
+	ret := make([]string, 0, len(domains))
+	for d := range domains {
+		ret = append(ret, d)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// deref drops any nil entries, graphql lists of nullable strings can have them
+func deref(values []*string) []string {
+	ret := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			ret = append(ret, *v)
+		}
+	}
+	return ret
 }
@@ -2,9 +2,11 @@ package gorbac
 
 import (
 	"bytes"
+	"fmt"
 	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io"
 	"strings"
 )
 
@@ -78,8 +80,8 @@ roles:
 				rbac, err = NewRbac(strings.NewReader(yaml))
 
 				Expect(err).To(BeNil())
-				Expect(len(rbac.yamlAll.Permissions)).To(Equal(7))
-				Expect(len(rbac.yamlAll.Roles)).To(Equal(3))
+				Expect(len(rbac.policy().Permissions)).To(Equal(7))
+				Expect(len(rbac.policy().Roles)).To(Equal(3))
 			})
 		})
 		Context("Valid role and permission", func() {
@@ -149,12 +151,12 @@ roles:
 				perms := []*string{&p}
 				parents := []*string{&pa}
 
-				new, err := rbac.UpsertRole(&r, perms, parents)
+				new, err := rbac.UpsertRole(&r, perms, parents, nil)
 				Expect(err).To(BeNil())
 				Expect(len(new.Parents)).To(Equal(1))
 				Expect(len(new.Permissions)).To(Equal(1))
-				Expect(len(rbac.yamlAll.Permissions)).To(Equal(8))
-				Expect(len(rbac.yamlAll.Roles)).To(Equal(4))
+				Expect(len(rbac.policy().Permissions)).To(Equal(8))
+				Expect(len(rbac.policy().Roles)).To(Equal(4))
 
 			})
 		})
@@ -166,21 +168,32 @@ roles:
 				perms := []*string{&p}
 				parents := []*string{&pa}
 
-				_, err := rbac.UpsertRole(&r, perms, parents)
+				_, err := rbac.UpsertRole(&r, perms, parents, nil)
 				Expect(err).To(HaveOccurred())
 
 			})
 		})
+		Context("Add parent which inherits from the role", func() {
+			It("should error", func() {
+				r := "editor"
+				pa := "chief-editor"
+				parents := []*string{&pa}
+
+				_, err := rbac.UpsertRole(&r, nil, parents, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(rbac.Validate(nil)).To(BeEmpty())
+			})
+		})
 		Context("Delete a role", func() {
 			It("should succeed", func() {
 				r := "new"
 
-				ok, err := rbac.DeleteRole(&r)
+				ok, err := rbac.DeleteRole(&r, nil)
 				Expect(err).To(BeNil())
 				Expect(ok).To(BeTrue())
 				// The permissions aren't reset until a save
-				Expect(len(rbac.yamlAll.Permissions)).To(Equal(8))
-				Expect(len(rbac.yamlAll.Roles)).To(Equal(3))
+				Expect(len(rbac.policy().Permissions)).To(Equal(8))
+				Expect(len(rbac.policy().Roles)).To(Equal(3))
 
 			})
 		})
@@ -188,7 +201,7 @@ roles:
 			It("should fail", func() {
 				r := "invalid"
 
-				_, err := rbac.DeleteRole(&r)
+				_, err := rbac.DeleteRole(&r, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -197,12 +210,12 @@ roles:
 				r := "editor"
 				p := "add-text"
 
-				ok, err := rbac.DeletePermission(&r, &p)
+				ok, err := rbac.DeletePermission(&r, &p, nil)
 				Expect(err).To(BeNil())
 				Expect(ok).To(BeTrue())
 				// The permissions aren't reset until a save
-				Expect(len(rbac.yamlAll.Permissions)).To(Equal(8))
-				Expect(len(rbac.yamlAll.Roles)).To(Equal(3))
+				Expect(len(rbac.policy().Permissions)).To(Equal(8))
+				Expect(len(rbac.policy().Roles)).To(Equal(3))
 
 			})
 		})
@@ -211,7 +224,7 @@ roles:
 				r := "invalid"
 				p := "new1"
 
-				_, err := rbac.DeletePermission(&r, &p)
+				_, err := rbac.DeletePermission(&r, &p, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -221,18 +234,114 @@ roles:
 				r := "editor"
 				p := "invalid"
 
-				_, err := rbac.DeletePermission(&r, &p)
+				_, err := rbac.DeletePermission(&r, &p, nil)
 				Expect(err).To(HaveOccurred())
 			})
 		})
 		Context("Can write yaml", func() {
 			It("should succeed", func() {
 				buf := new(bytes.Buffer)
-				rbac.Save(buf)
+				Expect(rbac.Save(buf, nil)).To(BeNil())
 
+				saved := &Serialize{}
+				Expect(LoadYaml(buf, saved)).To(BeNil())
+				Expect(len(saved.Permissions)).To(Equal(6))
+				Expect(len(saved.Roles)).To(Equal(3))
+
+				// saving doesn't change the live policy
+				Expect(len(rbac.policy().Permissions)).To(Equal(8))
+			})
+		})
+		Context("Reload with an unknown parent", func() {
+			It("should fail and keep the current policy", func() {
+				err := rbac.Reload(strings.NewReader(`
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text
+  parents:
+  - invalid`))
+				Expect(err).To(HaveOccurred())
+				Expect(len(rbac.policy().Roles)).To(Equal(3))
+				Expect(rbac.Check([]string{"photographer"}, "add-photo")).To(BeTrue())
+			})
+		})
+		Context("Reload invalid yaml", func() {
+			It("should fail and keep the current policy", func() {
+				err := rbac.Reload(strings.NewReader("roles: ["))
+				Expect(err).To(HaveOccurred())
+				Expect(len(rbac.policy().Roles)).To(Equal(3))
+			})
+		})
+		Context("Reload valid yaml", func() {
+			It("should replace the policy", func() {
+				err := rbac.Reload(strings.NewReader(`
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text`))
+				Expect(err).To(BeNil())
+				Expect(len(rbac.policy().Roles)).To(Equal(1))
+				Expect(rbac.Check([]string{"editor"}, "add-text")).To(BeTrue())
+				Expect(rbac.Check([]string{"archivist"}, "add-photo")).To(BeFalse())
+			})
+		})
+		Context("Restore when the write fails", func() {
+			It("should fail and keep the current policy", func() {
+				current := rbac.policy()
+				err := rbac.Restore(strings.NewReader(`
+permissions:
+- add-photo
+roles:
+ archivist:
+  permissions:
+  - add-photo`), func(fn func(writer io.Writer) error) error {
+					return fmt.Errorf("disk full")
+				}, nil)
+				Expect(err).To(MatchError("disk full"))
+				Expect(rbac.policy()).To(BeIdenticalTo(current))
+				Expect(rbac.Check([]string{"archivist"}, "add-photo")).To(BeFalse())
+			})
+		})
+		Context("Restore when the write succeeds", func() {
+			It("should replace the policy and write its new version", func() {
+				version := rbac.Version()
+				written := new(bytes.Buffer)
+				err := rbac.Restore(strings.NewReader(`
+version: 1
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text`), func(fn func(writer io.Writer) error) error {
+					return fn(written)
+				}, nil)
 				Expect(err).To(BeNil())
-				Expect(len(rbac.yamlAll.Permissions)).To(Equal(6))
-				Expect(len(rbac.yamlAll.Roles)).To(Equal(3))
+				Expect(rbac.Check([]string{"editor"}, "add-text")).To(BeTrue())
+				Expect(rbac.Version()).To(Equal(version + 1))
+
+				// a restart mustn't take the version back to the backup's
+				saved := &Serialize{}
+				Expect(LoadYaml(written, saved)).To(Succeed())
+				Expect(saved.Version).To(Equal(version + 1))
+				Expect(saved.Roles).To(HaveKey("editor"))
+
+				history, _ := rbac.History()
+				Expect(history[0].Change).To(Equal("restore"))
+			})
+		})
+		Context("Restore invalid yaml", func() {
+			It("should not write", func() {
+				err := rbac.Restore(strings.NewReader("roles: ["), func(fn func(writer io.Writer) error) error {
+					Fail("written")
+					return nil
+				}, nil)
+				Expect(err).To(HaveOccurred())
 			})
 		})
 	})
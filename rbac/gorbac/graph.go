@@ -0,0 +1,235 @@
+package gorbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+)
+
+// GraphRole is a role in the graph, Cycle is set if it inherits from itself
+type GraphRole struct {
+	Name        string        `json:"name"`
+	Permissions []string      `json:"permissions"`
+	Parents     []string      `json:"parents"`
+	Grants      []types.Grant `json:"grants"`
+	Cycle       bool          `json:"cycle"`
+}
+
+// GraphPermission is a permission in the graph, Orphan is set if no role grants it
+type GraphPermission struct {
+	Name   string `json:"name"`
+	Orphan bool   `json:"orphan"`
+}
+
+// RoleGraph is the roles, who they inherit from and what they grant
+type RoleGraph struct {
+	Roles       []GraphRole       `json:"roles"`
+	Permissions []GraphPermission `json:"permissions"`
+	Cycles      [][]string        `json:"cycles"`
+}
+
+// NewRoleGraph builds the graph of a policy, which needn't be valid so it can show what is wrong
+func NewRoleGraph(policy *Serialize) *RoleGraph {
+	names := roleNames(policy)
+	g := &RoleGraph{
+		Roles:       make([]GraphRole, 0, len(names)),
+		Permissions: make([]GraphPermission, 0, len(policy.Permissions)),
+		Cycles:      cycles(policy, names),
+	}
+
+	inCycle := make(map[string]bool)
+	for _, cycle := range g.Cycles {
+		for _, name := range cycle {
+			inCycle[name] = true
+		}
+	}
+
+	used := make(map[string]bool)
+	for _, name := range names {
+		role := policy.Roles[name]
+		g.Roles = append(g.Roles, GraphRole{
+			Name:        name,
+			Permissions: append([]string{}, role.Permissions...),
+			Parents:     append([]string{}, role.Parents...),
+			Grants:      role.Grants(),
+			Cycle:       inCycle[name],
+		})
+		for _, pid := range role.Permissions {
+			used[pid] = true
+		}
+		for _, g := range role.Grants() {
+			used[g.Permission] = true
+		}
+	}
+
+	all := append([]string{}, policy.Permissions...)
+	for pid := range used {
+		all = appendIfMissing(all, &pid)
+	}
+	sort.Strings(all)
+	for _, pid := range all {
+		g.Permissions = append(g.Permissions, GraphPermission{
+			Name:   pid,
+			Orphan: !used[pid],
+		})
+	}
+
+	return g
+}
+
+// RoleGraph renders the current policy
+func (r *Rbac) RoleGraph(writer io.Writer, format types.GraphFormat) error {
+	return NewRoleGraph(r.policy()).Render(writer, format)
+}
+
+// Render writes the graph as graphviz DOT, a mermaid flowchart or JSON
+// Edges point from a role to its parents and permissions, labelled with the domain for domain grants,
+// cycles and orphan permissions are in red
+func (g *RoleGraph) Render(writer io.Writer, format types.GraphFormat) error {
+	switch format {
+	case types.GraphDOT:
+		return g.dot(writer)
+	case types.GraphMermaid:
+		return g.mermaid(writer)
+	case types.GraphJSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(g)
+	}
+	return fmt.Errorf("Unknown graph format %s", format)
+}
+
+// cycleEdges is every parent edge which is part of a cycle
+func (g *RoleGraph) cycleEdges() map[[2]string]bool {
+	ret := make(map[[2]string]bool)
+	for _, cycle := range g.Cycles {
+		for i := 0; i < len(cycle)-1; i++ {
+			ret[[2]string{cycle[i], cycle[i+1]}] = true
+		}
+	}
+	return ret
+}
+
+func (g *RoleGraph) dot(writer io.Writer) error {
+	w := &errWriter{writer: writer}
+	edges := g.cycleEdges()
+
+	w.printf("digraph rbac {\n")
+	w.printf("  rankdir=BT;\n")
+	for _, r := range g.Roles {
+		if r.Cycle {
+			w.printf("  %q [shape=box, label=%q, color=red];\n", "role:"+r.Name, r.Name)
+		} else {
+			w.printf("  %q [shape=box, label=%q];\n", "role:"+r.Name, r.Name)
+		}
+	}
+	for _, p := range g.Permissions {
+		if p.Orphan {
+			w.printf("  %q [shape=ellipse, label=%q, color=red, style=dashed];\n", "permission:"+p.Name, p.Name)
+		} else {
+			w.printf("  %q [shape=ellipse, label=%q];\n", "permission:"+p.Name, p.Name)
+		}
+	}
+	for _, r := range g.Roles {
+		for _, parent := range r.Parents {
+			if edges[[2]string{r.Name, parent}] {
+				w.printf("  %q -> %q [color=red];\n", "role:"+r.Name, "role:"+parent)
+			} else {
+				w.printf("  %q -> %q;\n", "role:"+r.Name, "role:"+parent)
+			}
+		}
+		for _, pid := range r.Permissions {
+			w.printf("  %q -> %q [style=dotted];\n", "role:"+r.Name, "permission:"+pid)
+		}
+		for _, g := range r.Grants {
+			w.printf("  %q -> %q [style=dotted, label=%q];\n", "role:"+r.Name, "permission:"+g.Permission, g.Domain)
+		}
+	}
+	w.printf("}\n")
+
+	return w.err
+}
+
+func (g *RoleGraph) mermaid(writer io.Writer) error {
+	w := &errWriter{writer: writer}
+	edges := g.cycleEdges()
+
+	// mermaid ids can't have most punctuation so nodes are numbered
+	ids := make(map[string]string)
+	for i, r := range g.Roles {
+		ids["role:"+r.Name] = fmt.Sprintf("r%d", i)
+	}
+	for i, p := range g.Permissions {
+		ids["permission:"+p.Name] = fmt.Sprintf("p%d", i)
+	}
+	id := func(kind string, name string) string {
+		if v, ok := ids[kind+":"+name]; ok {
+			return v
+		}
+		// a dangling parent, give it a node so the edge still shows
+		v := fmt.Sprintf("x%d", len(ids))
+		ids[kind+":"+name] = v
+		return v
+	}
+
+	w.printf("graph BT\n")
+	for _, r := range g.Roles {
+		w.printf("  %s[%q]\n", id("role", r.Name), r.Name)
+	}
+	for _, p := range g.Permissions {
+		w.printf("  %s([%q])\n", id("permission", p.Name), p.Name)
+	}
+
+	link := 0
+	red := make([]string, 0)
+	for _, r := range g.Roles {
+		for _, parent := range r.Parents {
+			w.printf("  %s --> %s\n", id("role", r.Name), id("role", parent))
+			if edges[[2]string{r.Name, parent}] {
+				red = append(red, fmt.Sprint(link))
+			}
+			link++
+		}
+		for _, pid := range r.Permissions {
+			w.printf("  %s -.-> %s\n", id("role", r.Name), id("permission", pid))
+			link++
+		}
+		for _, g := range r.Grants {
+			w.printf("  %s -.->|%q| %s\n", id("role", r.Name), g.Domain, id("permission", g.Permission))
+			link++
+		}
+	}
+
+	w.printf("  classDef cycle stroke:red\n")
+	w.printf("  classDef orphan stroke:red,stroke-dasharray:5\n")
+	for _, r := range g.Roles {
+		if r.Cycle {
+			w.printf("  class %s cycle\n", id("role", r.Name))
+		}
+	}
+	for _, p := range g.Permissions {
+		if p.Orphan {
+			w.printf("  class %s orphan\n", id("permission", p.Name))
+		}
+	}
+	for _, l := range red {
+		w.printf("  linkStyle %s stroke:red\n", l)
+	}
+
+	return w.err
+}
+
+// errWriter keeps the first error so rendering needn't check every line
+type errWriter struct {
+	writer io.Writer
+	err    error
+}
+
+func (w *errWriter) printf(format string, args ...interface{}) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.writer, format, args...)
+	}
+}
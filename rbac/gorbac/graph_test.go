@@ -0,0 +1,123 @@
+package gorbac
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Graph", func() {
+
+	var (
+		policy *Serialize
+	)
+
+	BeforeEach(func() {
+		policy = &Serialize{}
+		Expect(LoadYaml(strings.NewReader(`
+permissions:
+- add-text
+- del-text
+roles:
+ editor:
+  permissions:
+  - add-text
+  parents:
+  - chief
+ chief:
+  parents:
+  - editor
+ writer:
+  parents:
+  - editor`), policy)).To(BeNil())
+	})
+
+	Context("Building", func() {
+		It("should find cycles and orphan permissions", func() {
+			g := NewRoleGraph(policy)
+
+			Expect(g.Cycles).To(Equal([][]string{{"chief", "editor", "chief"}}))
+			Expect(g.Roles).To(ContainElement(GraphRole{Name: "writer", Permissions: []string{}, Parents: []string{"editor"}, Grants: []types.Grant{}}))
+			Expect(g.Roles).To(ContainElement(GraphRole{Name: "chief", Permissions: []string{}, Parents: []string{"editor"}, Grants: []types.Grant{}, Cycle: true}))
+			Expect(g.Permissions).To(Equal([]GraphPermission{
+				{Name: "add-text"},
+				{Name: "del-text", Orphan: true},
+			}))
+		})
+	})
+	Context("Domain grants", func() {
+		BeforeEach(func() {
+			policy.Roles["writer"] = types.Role{
+				Parents: []string{"editor"},
+				Domains: map[string][]string{"times": {"del-text"}},
+			}
+		})
+		It("should use the permission", func() {
+			Expect(NewRoleGraph(policy).Permissions).To(ContainElement(GraphPermission{Name: "del-text"}))
+		})
+		It("should label the edge with the domain", func() {
+			var b bytes.Buffer
+			Expect(NewRoleGraph(policy).Render(&b, types.GraphDOT)).To(BeNil())
+			Expect(b.String()).To(ContainSubstring(`"role:writer" -> "permission:del-text" [style=dotted, label="times"];`))
+
+			b.Reset()
+			Expect(NewRoleGraph(policy).Render(&b, types.GraphMermaid)).To(BeNil())
+			Expect(b.String()).To(ContainSubstring(`r2 -.->|"times"| p1`))
+		})
+	})
+	Context("DOT", func() {
+		It("should mark cycles and orphans in red", func() {
+			var b bytes.Buffer
+			Expect(NewRoleGraph(policy).Render(&b, types.GraphDOT)).To(BeNil())
+
+			Expect(b.String()).To(HavePrefix("digraph rbac {"))
+			Expect(b.String()).To(ContainSubstring(`"role:chief" -> "role:editor" [color=red];`))
+			Expect(b.String()).To(ContainSubstring(`"role:writer" -> "role:editor";`))
+			Expect(b.String()).To(ContainSubstring(`"permission:del-text" [shape=ellipse, label="del-text", color=red, style=dashed];`))
+		})
+	})
+	Context("Mermaid", func() {
+		It("should mark cycles and orphans", func() {
+			var b bytes.Buffer
+			Expect(NewRoleGraph(policy).Render(&b, types.GraphMermaid)).To(BeNil())
+
+			Expect(b.String()).To(HavePrefix("graph BT"))
+			Expect(b.String()).To(ContainSubstring(`r0["chief"]`))
+			Expect(b.String()).To(ContainSubstring("r0 --> r1"))
+			Expect(b.String()).To(ContainSubstring("class r0 cycle"))
+			Expect(b.String()).To(ContainSubstring("class p1 orphan"))
+			Expect(b.String()).To(ContainSubstring("linkStyle 0 stroke:red"))
+		})
+	})
+	Context("JSON", func() {
+		It("should round trip", func() {
+			var b bytes.Buffer
+			Expect(NewRoleGraph(policy).Render(&b, types.GraphJSON)).To(BeNil())
+
+			g := &RoleGraph{}
+			Expect(json.Unmarshal(b.Bytes(), g)).To(BeNil())
+			Expect(g).To(Equal(NewRoleGraph(policy)))
+		})
+	})
+	Context("Live policy", func() {
+		It("should render", func() {
+			rbac, err := NewRbac(strings.NewReader(`
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text`))
+			Expect(err).To(BeNil())
+
+			var b bytes.Buffer
+			Expect(rbac.RoleGraph(&b, types.GraphDOT)).To(BeNil())
+			Expect(b.String()).To(ContainSubstring(`"role:editor" -> "permission:add-text" [style=dotted];`))
+			Expect(rbac.RoleGraph(&b, types.GraphFormat("PNG"))).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,194 @@
+package gorbac
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+)
+
+// HistorySize is how many revisions of the policy are kept in memory
+const HistorySize = 100
+
+type revision struct {
+	types.Revision
+	policy *Serialize
+}
+
+func (s *Serialize) clone() *Serialize {
+	ret := &Serialize{
+		Version:     s.Version,
+		Permissions: append([]string{}, s.Permissions...),
+		Roles:       make(map[string]types.Role, len(s.Roles)),
+	}
+	for k, v := range s.Roles {
+		ret.Roles[k] = v.Clone()
+	}
+	if s.Templates != nil {
+		ret.Templates = make(map[string]types.Role, len(s.Templates))
+		for k, v := range s.Templates {
+			ret.Templates[k] = v.Clone()
+		}
+	}
+	if s.Tenants != nil {
+		ret.Tenants = make(map[string]Tenant, len(s.Tenants))
+		for k, v := range s.Tenants {
+			ret.Tenants[k] = v.clone()
+		}
+	}
+	return ret
+}
+
+// record adds the current policy to the history, it must be called with the mutex held
+// Snapshots never change so the policy is shared rather than copied
+func (r *Rbac) record(m *types.Mutation, change string) {
+	policy := r.policy()
+	rev := revision{
+		Revision: types.Revision{
+			Version: policy.Version,
+			Time:    time.Now(),
+			Change:  change,
+		},
+		policy: policy,
+	}
+	if m != nil {
+		rev.User = m.User
+	}
+
+	r.history = append(r.history, rev)
+	if len(r.history) > HistorySize {
+		r.history = r.history[len(r.history)-HistorySize:]
+	}
+}
+
+// History returns the revisions of the policy, newest first
+func (r *Rbac) History() ([]types.Revision, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	ret := make([]types.Revision, 0, len(r.history))
+	for i := len(r.history) - 1; i >= 0; i-- {
+		ret = append(ret, r.history[i].Revision)
+	}
+	return ret, nil
+}
+
+// revision must be called with the mutex held
+func (r *Rbac) revision(version int) (*Serialize, error) {
+	for _, rev := range r.history {
+		if rev.Version == version {
+			return rev.policy, nil
+		}
+	}
+	return nil, fmt.Errorf("Version %d not found in history", version)
+}
+
+func (r *Rbac) Diff(from int, to int) (types.PolicyDiff, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	f, err := r.revision(from)
+	if err != nil {
+		return types.PolicyDiff{}, err
+	}
+	t, err := r.revision(to)
+	if err != nil {
+		return types.PolicyDiff{}, err
+	}
+
+	return DiffPolicy(f, t), nil
+}
+
+// Rollback makes an earlier revision the current policy, as a new version
+func (r *Rbac) Rollback(version int, m *types.Mutation) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := m.CheckVersion(r.policy().Version); err != nil {
+		return err
+	}
+
+	policy, err := r.revision(version)
+	if err != nil {
+		return err
+	}
+
+	return r.replace(policy.clone(), m, fmt.Sprintf("rollback to version %d", version))
+}
+
+// DiffPolicy describes the changes needed to get from one policy to another
+func DiffPolicy(from *Serialize, to *Serialize) types.PolicyDiff {
+	ret := types.PolicyDiff{
+		AddedRoles:         make([]string, 0),
+		RemovedRoles:       make([]string, 0),
+		ChangedRoles:       make([]types.RoleDiff, 0),
+		AddedPermissions:   difference(to.Permissions, from.Permissions),
+		RemovedPermissions: difference(from.Permissions, to.Permissions),
+	}
+
+	for _, name := range roleNames(to) {
+		old, ok := from.Roles[name]
+		if !ok {
+			ret.AddedRoles = append(ret.AddedRoles, name)
+			continue
+		}
+
+		cur := to.Roles[name]
+		diff := types.RoleDiff{
+			Name:               name,
+			AddedPermissions:   difference(cur.Permissions, old.Permissions),
+			RemovedPermissions: difference(old.Permissions, cur.Permissions),
+			AddedParents:       difference(cur.Parents, old.Parents),
+			RemovedParents:     difference(old.Parents, cur.Parents),
+			AddedGrants:        grantDifference(cur.Grants(), old.Grants()),
+			RemovedGrants:      grantDifference(old.Grants(), cur.Grants()),
+		}
+		if len(diff.AddedPermissions)+len(diff.RemovedPermissions)+len(diff.AddedParents)+len(diff.RemovedParents)+
+			len(diff.AddedGrants)+len(diff.RemovedGrants) > 0 {
+			ret.ChangedRoles = append(ret.ChangedRoles, diff)
+		}
+	}
+
+	for _, name := range roleNames(from) {
+		if _, ok := to.Roles[name]; !ok {
+			ret.RemovedRoles = append(ret.RemovedRoles, name)
+		}
+	}
+
+	return ret
+}
+
+// grantDifference is every grant in a which isn't in b, in the order of a
+func grantDifference(a []types.Grant, b []types.Grant) []types.Grant {
+	in := make(map[types.Grant]bool, len(b))
+	for _, g := range b {
+		in[g] = true
+	}
+
+	ret := make([]types.Grant, 0)
+	for _, g := range a {
+		if !in[g] {
+			ret = append(ret, g)
+			in[g] = true
+		}
+	}
+	return ret
+}
+
+// difference is everything in a which isn't in b, sorted
+func difference(a []string, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+
+	ret := make([]string, 0)
+	for _, v := range a {
+		if !in[v] {
+			ret = appendIfMissing(ret, &v)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,149 @@
+package gorbac
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("History", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+version: 1
+permissions:
+- add-text
+- add-photo
+roles:
+ editor:
+  permissions:
+  - add-text
+ photographer:
+  permissions:
+  - add-photo`))
+		Expect(err).To(BeNil())
+
+		r := "chief"
+		pa := "editor"
+		_, err = rbac.UpsertRole(&r, nil, []*string{&pa}, &types.Mutation{User: "alice"})
+		Expect(err).To(BeNil())
+
+		r = "photographer"
+		_, err = rbac.DeleteRole(&r, &types.Mutation{User: "bob"})
+		Expect(err).To(BeNil())
+	})
+
+	Context("Revisions", func() {
+		It("should record who changed what, newest first", func() {
+			history, err := rbac.History()
+			Expect(err).To(BeNil())
+			Expect(history).To(HaveLen(3))
+
+			Expect(history[0].Version).To(Equal(3))
+			Expect(history[0].User).To(Equal("bob"))
+			Expect(history[0].Change).To(Equal("delete role photographer"))
+
+			Expect(history[1].Version).To(Equal(2))
+			Expect(history[1].User).To(Equal("alice"))
+			Expect(history[1].Change).To(Equal("upsert role chief"))
+
+			Expect(history[2].Version).To(Equal(1))
+			Expect(history[2].Change).To(Equal("load"))
+			Expect(history[2].Time).NotTo(BeZero())
+		})
+	})
+	Context("Rejected mutations", func() {
+		It("should not be recorded", func() {
+			r := "invalid"
+			_, err := rbac.DeleteRole(&r, nil)
+			Expect(err).To(HaveOccurred())
+
+			history, _ := rbac.History()
+			Expect(history).To(HaveLen(3))
+		})
+	})
+	Context("Diff", func() {
+		It("should describe the changes", func() {
+			diff, err := rbac.Diff(1, 3)
+			Expect(err).To(BeNil())
+			Expect(diff.AddedRoles).To(Equal([]string{"chief"}))
+			Expect(diff.RemovedRoles).To(Equal([]string{"photographer"}))
+			Expect(diff.ChangedRoles).To(BeEmpty())
+		})
+		It("should describe changes within a role", func() {
+			r := "editor"
+			p := "add-text"
+			_, err := rbac.DeletePermission(&r, &p, nil)
+			Expect(err).To(BeNil())
+
+			diff, err := rbac.Diff(3, 4)
+			Expect(err).To(BeNil())
+			Expect(diff.ChangedRoles).To(Equal([]types.RoleDiff{{
+				Name:               "editor",
+				AddedPermissions:   []string{},
+				RemovedPermissions: []string{"add-text"},
+				AddedParents:       []string{},
+				RemovedParents:     []string{},
+				AddedGrants:        []types.Grant{},
+				RemovedGrants:      []types.Grant{},
+			}}))
+		})
+		It("should fail for unknown versions", func() {
+			_, err := rbac.Diff(1, 10)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	Context("Rollback", func() {
+		It("should restore the old policy as a new version", func() {
+			Expect(rbac.Rollback(1, &types.Mutation{User: "carol"})).To(BeNil())
+			Expect(rbac.Version()).To(Equal(4))
+
+			roles, err := rbac.GetRoles(nil)
+			Expect(err).To(BeNil())
+			Expect(roles).To(HaveKey("photographer"))
+			Expect(roles).NotTo(HaveKey("chief"))
+			Expect(rbac.Check([]string{"photographer"}, "add-photo")).To(BeTrue())
+
+			history, _ := rbac.History()
+			Expect(history[0].User).To(Equal("carol"))
+			Expect(history[0].Change).To(Equal("rollback to version 1"))
+
+			diff, err := rbac.Diff(1, 4)
+			Expect(err).To(BeNil())
+			Expect(diff).To(Equal(DiffPolicy(&Serialize{}, &Serialize{})))
+		})
+		It("should fail with a stale version", func() {
+			v := 2
+			err := rbac.Rollback(1, &types.Mutation{ExpectedVersion: &v})
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+			Expect(rbac.Version()).To(Equal(3))
+		})
+		It("should fail for unknown versions", func() {
+			Expect(rbac.Rollback(10, nil)).To(HaveOccurred())
+		})
+	})
+	Context("Long history", func() {
+		It("should only keep the newest revisions", func() {
+			r := "chief"
+			for i := 0; i < HistorySize; i++ {
+				_, err := rbac.UpsertRole(&r, nil, nil, nil)
+				Expect(err).To(BeNil())
+			}
+
+			history, _ := rbac.History()
+			Expect(history).To(HaveLen(HistorySize))
+			Expect(history[0].Version).To(Equal(rbac.Version()))
+
+			_, err := rbac.Diff(1, rbac.Version())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
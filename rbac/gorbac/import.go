@@ -0,0 +1,117 @@
+package gorbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+)
+
+// Export writes the current policy, unlike Save it doesn't tidy up unused permissions
+func (r *Rbac) Export(writer io.Writer, format types.Format) error {
+	policy := r.policy()
+
+	switch format {
+	case types.FormatYAML:
+		return SaveYaml(writer, policy)
+	case types.FormatJSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(policy)
+	}
+	return fmt.Errorf("Unknown format %s", format)
+}
+
+// Import reads a policy in either format, JSON being a subset of yaml, and replaces or merges it into the current one
+// A dry run only reports what would change
+func (r *Rbac) Import(reader io.Reader, mode types.ImportMode, dryRun bool, m *types.Mutation) (types.ImportResult, error) {
+	document := &Serialize{}
+	if err := LoadYaml(reader, document); err != nil {
+		return types.ImportResult{}, err
+	}
+	if document.Roles == nil {
+		document.Roles = make(map[string]types.Role)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	current := r.policy()
+	if err := m.CheckVersion(current.Version); err != nil {
+		return types.ImportResult{}, err
+	}
+
+	var policy *Serialize
+
+	switch mode {
+	case types.ImportReplace:
+		policy = document.clone()
+	case types.ImportMerge:
+		policy = current.clone()
+		for i := range document.Permissions {
+			policy.Permissions = appendIfMissing(policy.Permissions, &document.Permissions[i])
+		}
+		for _, name := range roleNames(document) {
+			role := document.Roles[name]
+			// not found is an empty role so it gets added, parents are checked by the validation below
+			merged := policy.Roles[name]
+			for i := range role.Permissions {
+				merged.Permissions = appendIfMissing(merged.Permissions, &role.Permissions[i])
+				policy.Permissions = appendIfMissing(policy.Permissions, &role.Permissions[i])
+			}
+			for i := range role.Parents {
+				merged.Parents = appendIfMissing(merged.Parents, &role.Parents[i])
+			}
+			for _, d := range sortedKeys(role.Domains) {
+				if merged.Domains == nil {
+					merged.Domains = make(map[string][]string)
+				}
+				perms := role.Domains[d]
+				for i := range perms {
+					merged.Domains[d] = appendIfMissing(merged.Domains[d], &perms[i])
+					policy.Permissions = appendIfMissing(policy.Permissions, &perms[i])
+				}
+			}
+			policy.Roles[name] = merged
+		}
+		// templates aren't checked until they're used so the document's simply win
+		for name, t := range document.Templates {
+			if policy.Templates == nil {
+				policy.Templates = make(map[string]types.Role)
+			}
+			policy.Templates[name] = t
+		}
+		// a tenant in the document replaces the tenant's roles, tenants are their admins' to merge
+		for name, t := range document.Tenants {
+			if policy.Tenants == nil {
+				policy.Tenants = make(map[string]Tenant)
+			}
+			policy.Tenants[name] = t
+		}
+	default:
+		return types.ImportResult{}, fmt.Errorf("Unknown import mode %s", mode)
+	}
+
+	if errs := Errors(ValidatePolicy(policy, nil)); len(errs) > 0 {
+		return types.ImportResult{}, fmt.Errorf("Invalid policy, %s", errs[0].Message)
+	}
+
+	result := types.ImportResult{
+		DryRun:  dryRun,
+		Version: current.Version,
+		Diff:    DiffPolicy(current, policy),
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	// the document's version means nothing here, the import is a new version
+	policy.Version = 0
+	if err := r.replace(policy, m, fmt.Sprintf("import %s", mode)); err != nil {
+		return types.ImportResult{}, err
+	}
+
+	result.Version = policy.Version
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package gorbac
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Import", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+version: 4
+permissions:
+- add-text
+- add-photo
+roles:
+ editor:
+  permissions:
+  - add-text
+ photographer:
+  permissions:
+  - add-photo`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Export", func() {
+		It("should round trip as yaml", func() {
+			var b bytes.Buffer
+			Expect(rbac.Export(&b, types.FormatYAML)).To(BeNil())
+
+			policy := &Serialize{}
+			Expect(LoadYaml(&b, policy)).To(BeNil())
+			Expect(policy.Version).To(Equal(4))
+			Expect(policy.Roles).To(HaveKey("photographer"))
+		})
+		It("should round trip as json", func() {
+			var b bytes.Buffer
+			Expect(rbac.Export(&b, types.FormatJSON)).To(BeNil())
+
+			policy := &Serialize{}
+			Expect(json.Unmarshal(b.Bytes(), policy)).To(BeNil())
+			Expect(policy.Roles["editor"].Permissions).To(Equal([]string{"add-text"}))
+		})
+		It("should not know other formats", func() {
+			Expect(rbac.Export(&bytes.Buffer{}, types.Format("XML"))).To(HaveOccurred())
+		})
+	})
+	Context("Replace", func() {
+		It("should make the document the policy as a new version", func() {
+			result, err := rbac.Import(strings.NewReader(`{"version": 1, "permissions": ["add-text"], "roles": {"writer": {"permissions": ["add-text"]}}}`), types.ImportReplace, false, &types.Mutation{User: "alice"})
+			Expect(err).To(BeNil())
+			Expect(result.Version).To(Equal(5))
+			Expect(result.Diff.AddedRoles).To(Equal([]string{"writer"}))
+			Expect(result.Diff.RemovedRoles).To(Equal([]string{"editor", "photographer"}))
+
+			Expect(rbac.Version()).To(Equal(5))
+			Expect(rbac.Check([]string{"writer"}, "add-text")).To(BeTrue())
+			Expect(rbac.Check([]string{"photographer"}, "add-photo")).To(BeFalse())
+
+			history, _ := rbac.History()
+			Expect(history[0].User).To(Equal("alice"))
+			Expect(history[0].Change).To(Equal("import REPLACE"))
+		})
+	})
+	Context("Merge", func() {
+		It("should add to the policy", func() {
+			result, err := rbac.Import(strings.NewReader(`
+roles:
+ editor:
+  permissions:
+  - add-photo
+ chief:
+  parents:
+  - editor`), types.ImportMerge, false, nil)
+			Expect(err).To(BeNil())
+			Expect(result.Diff.AddedRoles).To(Equal([]string{"chief"}))
+			Expect(result.Diff.ChangedRoles).To(HaveLen(1))
+			Expect(result.Diff.ChangedRoles[0].AddedPermissions).To(Equal([]string{"add-photo"}))
+
+			Expect(rbac.Check([]string{"chief"}, "add-photo")).To(BeTrue())
+			Expect(rbac.Check([]string{"photographer"}, "add-photo")).To(BeTrue())
+		})
+	})
+	Context("Dry run", func() {
+		It("should report the diff without changing anything", func() {
+			result, err := rbac.Import(strings.NewReader(`roles: {}`), types.ImportReplace, true, nil)
+			Expect(err).To(BeNil())
+			Expect(result.DryRun).To(BeTrue())
+			Expect(result.Version).To(Equal(4))
+			Expect(result.Diff.RemovedRoles).To(HaveLen(2))
+
+			Expect(rbac.Version()).To(Equal(4))
+			Expect(rbac.Check([]string{"editor"}, "add-text")).To(BeTrue())
+		})
+	})
+	Context("Rejected imports", func() {
+		It("should not apply an invalid policy", func() {
+			_, err := rbac.Import(strings.NewReader(`
+roles:
+ chief:
+  parents:
+  - nobody`), types.ImportMerge, false, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(rbac.Version()).To(Equal(4))
+		})
+		It("should not apply against a stale version", func() {
+			expected := 3
+			_, err := rbac.Import(strings.NewReader(`roles: {}`), types.ImportReplace, false, &types.Mutation{ExpectedVersion: &expected})
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+		})
+		It("should not know other modes", func() {
+			_, err := rbac.Import(strings.NewReader(`roles: {}`), types.ImportMode("APPEND"), false, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package gorbac
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Live", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+permissions:
+- add-text
+- add-photo
+roles:
+ editor:
+  permissions:
+  - add-text
+ photographer:
+  permissions:
+  - add-photo`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Granting", func() {
+		It("should take effect without saving", func() {
+			r := "editor"
+			p := "add-photo"
+			Expect(rbac.Check([]string{"editor"}, "add-photo")).To(BeFalse())
+
+			_, err := rbac.UpsertRole(&r, []*string{&p}, nil, nil)
+			Expect(err).To(BeNil())
+			Expect(rbac.Check([]string{"editor"}, "add-photo")).To(BeTrue())
+		})
+		It("should take effect through a new parent", func() {
+			r := "chief"
+			pa := "photographer"
+
+			_, err := rbac.UpsertRole(&r, nil, []*string{&pa}, nil)
+			Expect(err).To(BeNil())
+			Expect(rbac.Check([]string{"chief"}, "add-photo")).To(BeTrue())
+		})
+	})
+	Context("Revoking", func() {
+		It("should stop a deleted role granting", func() {
+			r := "editor"
+
+			_, err := rbac.DeleteRole(&r, nil)
+			Expect(err).To(BeNil())
+			Expect(rbac.Check([]string{"editor"}, "add-text")).To(BeFalse())
+		})
+		It("should stop a deleted permission granting", func() {
+			r := "photographer"
+			p := "add-photo"
+
+			_, err := rbac.DeletePermission(&r, &p, nil)
+			Expect(err).To(BeNil())
+			Expect(rbac.Check([]string{"photographer"}, "add-photo")).To(BeFalse())
+		})
+	})
+	Context("Rejected mutations", func() {
+		It("should leave the graph alone", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeDeleteRole, Name: "editor"},
+				{Op: types.ChangeDeleteRole, Name: "invalid"},
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(rbac.Check([]string{"editor"}, "add-text")).To(BeTrue())
+		})
+	})
+	Context("Concurrent checks", func() {
+		It("should see each mutation whole", func() {
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for i := 0; i < 50; i++ {
+					r := fmt.Sprintf("role-%d", i)
+					p := "add-text"
+					_, err := rbac.UpsertRole(&r, []*string{&p}, nil, nil)
+					Expect(err).To(BeNil())
+					rbac.Save(&bytes.Buffer{}, nil)
+				}
+			}()
+
+			for i := 0; i < 4; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 50; j++ {
+						rbac.Check([]string{"editor", fmt.Sprintf("role-%d", j)}, "add-text")
+						rbac.GetRoles(nil)
+						rbac.GetPermissions(nil)
+					}
+				}()
+			}
+
+			wg.Wait()
+			Expect(rbac.Check([]string{"role-49"}, "add-text")).To(BeTrue())
+		})
+	})
+})
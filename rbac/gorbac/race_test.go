@@ -0,0 +1,214 @@
+package gorbac
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/persist"
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// run with go test -race, make race does
+var _ = Describe("Race", func() {
+
+	const (
+		readers = 8
+		rounds  = 100
+	)
+
+	var (
+		rbac *Rbac
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+permissions:
+- add-text
+- add-photo
+roles:
+ editor:
+  permissions:
+  - add-text
+ chief:
+  parents:
+  - editor`))
+		Expect(err).To(BeNil())
+	})
+
+	// readers hammer the policy while writers use every kind of mutation
+	// editor and chief are never touched so must always be granted
+	It("should check concurrently with mutations", func() {
+		var (
+			wg      sync.WaitGroup
+			writers sync.WaitGroup
+			done    = make(chan struct{})
+		)
+
+		for i := 0; i < readers; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				last := 0
+				for {
+					select {
+					case <-done:
+						return
+					default:
+					}
+
+					Expect(rbac.Check([]string{"chief"}, "add-text")).To(BeTrue())
+					rbac.CheckDomain([]string{"chief"}, nil, "add-text")
+
+					version := rbac.Version()
+					Expect(version).To(BeNumerically(">=", last))
+					last = version
+
+					roles, err := rbac.GetRoles(nil)
+					Expect(err).To(BeNil())
+					Expect(roles).To(HaveKey("editor"))
+
+					_, err = rbac.GetPermissions(nil)
+					Expect(err).To(BeNil())
+					_, err = rbac.History()
+					Expect(err).To(BeNil())
+				}
+			}()
+		}
+
+		mutate := func(f func(i int)) {
+			writers.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer writers.Done()
+				for i := 0; i < rounds; i++ {
+					f(i)
+				}
+			}()
+		}
+
+		mutate(func(i int) {
+			r := fmt.Sprintf("writer-%d", i)
+			p := "add-photo"
+			_, err := rbac.UpsertRole(&r, []*string{&p}, nil, nil)
+			Expect(err).To(BeNil())
+			// a reload or rollback may have removed it already
+			rbac.DeleteRole(&r, nil)
+		})
+		mutate(func(i int) {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeUpsertRole, Name: "photographer", Permissions: []string{"add-photo"}},
+				{Op: types.ChangeDeletePermission, Name: "photographer", Permission: "add-photo"},
+			}, nil)
+			Expect(err).To(BeNil())
+		})
+		mutate(func(i int) {
+			_, err := rbac.Import(strings.NewReader(fmt.Sprintf(`
+roles:
+ designer-%d:
+  parents:
+  - editor`, i)), types.ImportMerge, false, nil)
+			Expect(err).To(BeNil())
+			Expect(rbac.Save(&bytes.Buffer{}, nil)).To(BeNil())
+		})
+		mutate(func(i int) {
+			// the version before may have been replaced already, which is fine
+			rbac.Rollback(rbac.Version()-1, nil)
+		})
+		mutate(func(i int) {
+			Expect(rbac.Reload(strings.NewReader(`
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text
+ chief:
+  parents:
+  - editor`))).To(BeNil())
+		})
+
+		writers.Wait()
+		close(done)
+		wg.Wait()
+
+		// upserts, batches, imports and reloads always apply, deletes and rollbacks may not
+		Expect(rbac.Version()).To(BeNumerically(">", 4*rounds))
+	})
+
+	// the watcher reloads on its own goroutine, checks must never see a policy half swapped
+	It("should check concurrently with hot reloads", func() {
+		dir, err := ioutil.TempDir("", "race")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "all.yaml")
+		policy := func(i int) []byte {
+			return []byte(fmt.Sprintf(`
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text
+ chief:
+  parents:
+  - editor
+ writer-%d:
+  parents:
+  - editor`, i))
+		}
+		Expect(ioutil.WriteFile(path, policy(0), 0644)).To(Succeed())
+
+		reloads := make(chan error, rounds)
+		watcher, err := persist.NewFile(path, 0).Watch(rbac, func(err error) {
+			select {
+			case reloads <- err:
+			default:
+			}
+		})
+		Expect(err).To(BeNil())
+		defer watcher.Close()
+
+		var (
+			wg   sync.WaitGroup
+			done = make(chan struct{})
+		)
+		for i := 0; i < readers; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for {
+					select {
+					case <-done:
+						return
+					default:
+					}
+					Expect(rbac.Check([]string{"chief"}, "add-text")).To(BeTrue())
+					Expect(rbac.CheckDomain([]string{"chief"}, nil, "add-text")).To(BeFalse())
+				}
+			}()
+		}
+
+		for i := 1; i <= 5; i++ {
+			Expect(ioutil.WriteFile(path, policy(i), 0644)).To(Succeed())
+			Eventually(reloads, time.Second).Should(Receive(BeNil()))
+		}
+
+		close(done)
+		wg.Wait()
+
+		Expect(rbac.Check([]string{"writer-5"}, "add-text")).To(BeTrue())
+	})
+})
@@ -0,0 +1,136 @@
+package gorbac
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Templates", func() {
+
+	var (
+		rbac  *Rbac
+		times = "times"
+		mail  = "mail"
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+permissions:
+- mod-newspaper
+- mod-story
+- read-story
+- add-text
+roles:
+ newspaper-admin:
+  permissions:
+  - mod-newspaper
+templates:
+ "{domain}-editor":
+  permissions:
+  - "{domain}-add-text"
+  domains:
+   "{domain}":
+   - mod-story
+  parents:
+  - "{domain}-reader"
+ "{domain}-reader":
+  domains:
+   "{domain}":
+   - read-story`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Loading", func() {
+		It("should return a copy of the templates", func() {
+			templates, err := rbac.Templates()
+			Expect(err).To(BeNil())
+			Expect(templates).To(HaveLen(2))
+			Expect(templates["{domain}-editor"].Parents).To(Equal([]string{"{domain}-reader"}))
+
+			templates["{domain}-editor"].Parents[0] = "changed"
+			templates, _ = rbac.Templates()
+			Expect(templates["{domain}-editor"].Parents).To(Equal([]string{"{domain}-reader"}))
+		})
+		It("should not create any roles", func() {
+			roles, err := rbac.GetRoles(nil)
+			Expect(err).To(BeNil())
+			Expect(roles).To(HaveLen(1))
+		})
+	})
+
+	Context("Provisioning", func() {
+		It("should create the parents first", func() {
+			templates, _ := rbac.Templates()
+			changes := types.ProvisionChanges(templates, "times")
+
+			Expect(changes).To(HaveLen(2))
+			Expect(changes[0].Name).To(Equal("times-reader"))
+			Expect(changes[1].Name).To(Equal("times-editor"))
+			Expect(changes[1].Permissions).To(Equal([]string{"times-add-text"}))
+			Expect(changes[1].Domains).To(Equal(map[string][]string{"times": {"mod-story"}}))
+			Expect(changes[1].Parents).To(Equal([]string{"times-reader"}))
+		})
+		It("should grant the domain permissions", func() {
+			templates, _ := rbac.Templates()
+			_, err := rbac.ApplyChanges(types.ProvisionChanges(templates, "times"), nil)
+			Expect(err).To(BeNil())
+
+			Expect(rbac.CheckDomain([]string{"times-editor"}, &times, "READ_STORY")).To(BeTrue())
+			Expect(rbac.CheckDomain([]string{"times-editor"}, &mail, "READ_STORY")).To(BeFalse())
+		})
+	})
+
+	Context("Removing a permission", func() {
+		BeforeEach(func() {
+			templates, _ := rbac.Templates()
+			_, err := rbac.ApplyChanges(types.ProvisionChanges(templates, "times"), nil)
+			Expect(err).To(BeNil())
+		})
+		It("should take it from the policy and every role", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeRemovePermission, Permission: "times-add-text"},
+			}, nil)
+			Expect(err).To(BeNil())
+
+			permission, role := "times-add-text", "times-editor"
+			_, err = rbac.GetPermissions(&permission)
+			Expect(err).NotTo(BeNil())
+			roles, _ := rbac.GetRoles(&role)
+			Expect(roles["times-editor"].Permissions).To(BeEmpty())
+		})
+		It("should take it from every domain", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeDeleteRole, Name: "times-editor"},
+				{Op: types.ChangeRemovePermission, Permission: "read-story"},
+			}, nil)
+			Expect(err).To(BeNil())
+
+			role := "times-reader"
+			roles, _ := rbac.GetRoles(&role)
+			Expect(roles["times-reader"].Domains).To(BeEmpty())
+		})
+		It("should fail if nothing has it", func() {
+			_, err := rbac.ApplyChanges([]types.Change{
+				{Op: types.ChangeRemovePermission, Permission: "mail-read-story"},
+			}, nil)
+			Expect(err).To(MatchError("Permission mail-read-story not found"))
+		})
+	})
+
+	Context("Saving", func() {
+		It("should keep the templates", func() {
+			var buf bytes.Buffer
+			Expect(rbac.Save(&buf, nil)).To(BeNil())
+
+			saved, err := NewRbac(&buf)
+			Expect(err).To(BeNil())
+			templates, _ := saved.Templates()
+			Expect(templates).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,210 @@
+package gorbac
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	"github.com/mikespook/gorbac"
+)
+
+// Tenant is a tenant's own policy, layered over the global one
+// Its roles only grant permissions within the tenant's domain, they can only have parents in the same tenant
+// and can use the global permissions as well as the tenant's own
+type Tenant struct {
+	Permissions []string              `yaml:"permissions" json:"permissions"`
+	Roles       map[string]types.Role `yaml:"roles" json:"roles"`
+}
+
+func (t Tenant) clone() Tenant {
+	ret := Tenant{
+		Permissions: append([]string{}, t.Permissions...),
+		Roles:       make(map[string]types.Role, len(t.Roles)),
+	}
+	for k, v := range t.Roles {
+		ret.Roles[k] = v.Clone()
+	}
+	return ret
+}
+
+// layer is the tenant as a policy of its own, with the global permissions declared, for validating and changing it
+func (t Tenant) layer(global *Serialize) *Serialize {
+	ret := &Serialize{
+		Version:     global.Version,
+		Permissions: append([]string{}, global.Permissions...),
+		Roles:       t.Roles,
+	}
+	for i := range t.Permissions {
+		ret.Permissions = appendIfMissing(ret.Permissions, &t.Permissions[i])
+	}
+	if ret.Roles == nil {
+		ret.Roles = make(map[string]types.Role)
+	}
+	return ret
+}
+
+// tenantNames is the tenants in order, so issues are reported the same way each time
+func tenantNames(policy *Serialize) []string {
+	ret := make([]string, 0, len(policy.Tenants))
+	for name := range policy.Tenants {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// validateTenants reports the errors in each tenant's layer, the warnings are left to the tenant's admins
+func validateTenants(policy *Serialize) []Issue {
+	issues := make([]Issue, 0)
+	for _, tenant := range tenantNames(policy) {
+		if tenant == "" || tenant == types.AnyDomain {
+			issues = append(issues, Issue{
+				Kind:    InvalidTenant,
+				Error:   true,
+				Message: fmt.Sprintf("tenant %q isn't a domain", tenant),
+			})
+			continue
+		}
+
+		layer := policy.Tenants[tenant].layer(policy)
+		for _, i := range Errors(ValidatePolicy(layer, nil)) {
+			i.Tenant = tenant
+			i.Message = fmt.Sprintf("tenant %s %s", tenant, i.Message)
+			issues = append(issues, i)
+		}
+		for _, name := range roleNames(layer) {
+			if len(layer.Roles[name].Domains) > 0 {
+				issues = append(issues, Issue{
+					Kind:    InvalidTenant,
+					Error:   true,
+					Role:    name,
+					Tenant:  tenant,
+					Message: fmt.Sprintf("tenant %s role %s grants permissions in other domains", tenant, name),
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// newTenantGraph builds the graph for a tenant's roles, permissions are only found by id so each role gets its own
+func newTenantGraph(t Tenant) (*gorbac.RBAC, error) {
+	ret := gorbac.New()
+	for k, v := range t.Roles {
+		role := gorbac.NewStdRole(k)
+		for _, pid := range v.Permissions {
+			role.Assign(gorbac.NewStdPermission(pid))
+		}
+		ret.Add(role)
+	}
+	for k, v := range t.Roles {
+		if err := ret.SetParents(k, v.Parents); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// applyTenantChange makes the change to the tenant's layer, adding the tenant on its first upsert
+func applyTenantChange(policy *Serialize, c types.Change) error {
+	t, ok := policy.Tenants[c.Tenant]
+	if !ok && c.Op != types.ChangeUpsertRole {
+		return fmt.Errorf("Tenant %s not found", c.Tenant)
+	}
+
+	tenant := c.Tenant
+	layer := t.layer(policy)
+	c.Tenant = ""
+	if err := applyChange(layer, c); err != nil {
+		return err
+	}
+
+	// the tenant only declares the permissions the global policy doesn't
+	t.Roles = layer.Roles
+	t.Permissions = make([]string, 0)
+	for _, pid := range layer.Permissions {
+		if !contains(policy.Permissions, pid) {
+			t.Permissions = append(t.Permissions, pid)
+		}
+	}
+
+	if policy.Tenants == nil {
+		policy.Tenants = make(map[string]Tenant)
+	}
+	policy.Tenants[tenant] = t
+	return nil
+}
+
+func contains(slice []string, value string) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rbac) Tenants() ([]string, error) {
+	return tenantNames(r.policy()), nil
+}
+
+func (r *Rbac) GetTenantRoles(tenant string, name *string) (map[string]types.Role, error) {
+	all := r.policy()
+	t, ok := all.Tenants[tenant]
+	if !ok {
+		return nil, fmt.Errorf("Tenant %s not found", tenant)
+	}
+	layer := t.layer(all)
+	if name == nil {
+		ret := make(map[string]types.Role, len(layer.Roles))
+		for k := range layer.Roles {
+			ret[k] = layer.role(k)
+		}
+		return ret, nil
+	}
+	if _, ok := layer.Roles[*name]; ok {
+		return map[string]types.Role{*name: layer.role(*name)}, nil
+	}
+	return nil, fmt.Errorf("Role %s not found in %s", *name, tenant)
+}
+
+// GetTenantPermissions is the permissions the tenant declares itself, its roles can use the global ones too
+func (r *Rbac) GetTenantPermissions(tenant string) ([]string, error) {
+	t, ok := r.policy().Tenants[tenant]
+	if !ok {
+		return nil, fmt.Errorf("Tenant %s not found", tenant)
+	}
+	return append([]string{}, t.Permissions...), nil
+}
+
+func (r *Rbac) UpsertTenantRole(tenant string, name *string, perms []*string, parents []*string, m *types.Mutation) (types.Role, error) {
+	policy, err := r.apply([]types.Change{{
+		Op:          types.ChangeUpsertRole,
+		Tenant:      tenant,
+		Name:        *name,
+		Permissions: deref(perms),
+		Parents:     deref(parents),
+	}}, m, fmt.Sprintf("upsert role %s in tenant %s", *name, tenant))
+	if err != nil {
+		return types.Role{}, err
+	}
+
+	return policy.Tenants[tenant].layer(policy).role(*name), nil
+}
+
+func (r *Rbac) DeleteTenantRole(tenant string, name *string, m *types.Mutation) (bool, error) {
+	_, err := r.apply([]types.Change{{
+		Op:     types.ChangeDeleteRole,
+		Tenant: tenant,
+		Name:   *name,
+	}}, m, fmt.Sprintf("delete role %s in tenant %s", *name, tenant))
+	return err == nil, err
+}
+
+// CheckTenant checks the tenant's roles, which only grant within the tenant's domain
+func (r *Rbac) CheckTenant(roles []string, tenant string, domain *string, permission string) bool {
+	if domain == nil || !types.InDomain(*domain, tenant) {
+		return false
+	}
+	return r.snapshot().checkTenant(roles, tenant, permission)
+}
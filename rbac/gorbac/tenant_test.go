@@ -0,0 +1,237 @@
+package gorbac
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tenants", func() {
+
+	var (
+		rbac      *Rbac
+		times     = "times"
+		timesPort = "times/sport"
+		mail      = "mail"
+	)
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+permissions:
+- mod-story
+- read-story
+- tenant-admin
+roles:
+ times-admin:
+  domains:
+   times:
+   - tenant-admin
+ reader:
+  domains:
+   "*":
+   - read-story
+tenants:
+ times:
+  permissions:
+  - add-crossword
+  roles:
+   night-desk:
+    permissions:
+    - mod-story
+    parents:
+    - crossword
+   crossword:
+    permissions:
+    - add-crossword
+ mail:
+  roles:
+   night-desk:
+    permissions:
+    - read-story`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Checking", func() {
+		It("should grant the tenant's roles within the tenant", func() {
+			Expect(rbac.CheckTenant([]string{"night-desk"}, times, &times, "MOD_STORY")).To(BeTrue())
+			Expect(rbac.CheckTenant([]string{"night-desk"}, times, &timesPort, "MOD_STORY")).To(BeTrue())
+			Expect(rbac.CheckTenant([]string{"night-desk"}, times, &times, "ADD_CROSSWORD")).To(BeTrue())
+		})
+		It("should not grant outside the tenant", func() {
+			Expect(rbac.CheckTenant([]string{"night-desk"}, times, &mail, "MOD_STORY")).To(BeFalse())
+			Expect(rbac.CheckTenant([]string{"night-desk"}, times, nil, "MOD_STORY")).To(BeFalse())
+		})
+		It("should keep tenants' roles of the same name apart", func() {
+			Expect(rbac.CheckTenant([]string{"night-desk"}, mail, &mail, "MOD_STORY")).To(BeFalse())
+			Expect(rbac.CheckTenant([]string{"night-desk"}, mail, &mail, "READ_STORY")).To(BeTrue())
+		})
+		It("should not grant through the global policy", func() {
+			Expect(rbac.CheckDomain([]string{"night-desk"}, &times, "MOD_STORY")).To(BeFalse())
+			Expect(rbac.Check([]string{"night-desk"}, "MOD_STORY")).To(BeFalse())
+			Expect(rbac.CheckTenant([]string{"reader"}, times, &times, "READ_STORY")).To(BeFalse())
+		})
+		It("should not grant an unknown tenant", func() {
+			other := "other"
+			Expect(rbac.CheckTenant([]string{"night-desk"}, other, &other, "MOD_STORY")).To(BeFalse())
+		})
+	})
+
+	Context("Reading", func() {
+		It("should list the tenants", func() {
+			Expect(rbac.Tenants()).To(Equal([]string{"mail", "times"}))
+		})
+		It("should return the tenant's roles", func() {
+			roles, err := rbac.GetTenantRoles(times, nil)
+			Expect(err).To(BeNil())
+			Expect(roles).To(HaveLen(2))
+			Expect(roles["night-desk"].Parents).To(Equal([]string{"crossword"}))
+			Expect(roles["night-desk"].Version).To(Equal(rbac.Version()))
+
+			name := "reader"
+			_, err = rbac.GetTenantRoles(times, &name)
+			Expect(err).To(MatchError("Role reader not found in times"))
+		})
+		It("should return the tenant's own permissions", func() {
+			Expect(rbac.GetTenantPermissions(times)).To(Equal([]string{"add-crossword"}))
+		})
+		It("should fail for an unknown tenant", func() {
+			_, err := rbac.GetTenantRoles("other", nil)
+			Expect(err).To(MatchError("Tenant other not found"))
+			_, err = rbac.GetTenantPermissions("other")
+			Expect(err).To(MatchError("Tenant other not found"))
+		})
+	})
+
+	Context("Changing", func() {
+		It("should upsert a role in the tenant only", func() {
+			name, perm, parent := "sub-editor", "mod-story", "crossword"
+			role, err := rbac.UpsertTenantRole(times, &name, []*string{&perm}, []*string{&parent}, &types.Mutation{User: "alice"})
+			Expect(err).To(BeNil())
+			Expect(role.Permissions).To(Equal([]string{"mod-story"}))
+			Expect(role.Version).To(Equal(rbac.Version()))
+
+			Expect(rbac.CheckTenant([]string{"sub-editor"}, times, &times, "ADD_CROSSWORD")).To(BeTrue())
+			_, err = rbac.GetRoles(&name)
+			Expect(err).To(MatchError("Role sub-editor not found"))
+
+			history, _ := rbac.History()
+			Expect(history[0].Change).To(Equal("upsert role sub-editor in tenant times"))
+			Expect(history[0].User).To(Equal("alice"))
+		})
+		It("should declare new permissions in the tenant", func() {
+			name, perm := "puzzles", "add-sudoku"
+			_, err := rbac.UpsertTenantRole(times, &name, []*string{&perm}, nil, nil)
+			Expect(err).To(BeNil())
+
+			Expect(rbac.GetTenantPermissions(times)).To(Equal([]string{"add-crossword", "add-sudoku"}))
+			Expect(rbac.GetPermissions(nil)).NotTo(ContainElement("add-sudoku"))
+		})
+		It("should add a tenant on its first role", func() {
+			name, perm, tenant := "editor", "mod-story", "the-bugle"
+			_, err := rbac.UpsertTenantRole(tenant, &name, []*string{&perm}, nil, nil)
+			Expect(err).To(BeNil())
+
+			Expect(rbac.Tenants()).To(ContainElement(tenant))
+			Expect(rbac.CheckTenant([]string{"editor"}, tenant, &tenant, "MOD_STORY")).To(BeTrue())
+		})
+		It("should only have parents in the same tenant", func() {
+			name, parent := "sub-editor", "reader"
+			_, err := rbac.UpsertTenantRole(times, &name, nil, []*string{&parent}, nil)
+			Expect(err).To(MatchError("Parent role reader not found"))
+		})
+		It("should delete a role in the tenant", func() {
+			name := "night-desk"
+			ok, err := rbac.DeleteTenantRole(mail, &name, nil)
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeTrue())
+
+			Expect(rbac.CheckTenant([]string{"night-desk"}, mail, &mail, "READ_STORY")).To(BeFalse())
+			Expect(rbac.CheckTenant([]string{"night-desk"}, times, &times, "MOD_STORY")).To(BeTrue())
+		})
+		It("should not delete a parent", func() {
+			name := "crossword"
+			_, err := rbac.DeleteTenantRole(times, &name, nil)
+			Expect(err).To(MatchError(ContainSubstring("tenant times role night-desk has parent crossword which isn't a role")))
+		})
+		It("should not delete from an unknown tenant", func() {
+			name := "night-desk"
+			_, err := rbac.DeleteTenantRole("other", &name, nil)
+			Expect(err).To(MatchError("Tenant other not found"))
+		})
+		It("should check the expected version", func() {
+			name, expected := "night-desk", rbac.Version()+1
+			_, err := rbac.DeleteTenantRole(mail, &name, &types.Mutation{ExpectedVersion: &expected})
+			Expect(err).To(MatchError(types.ErrConflict))
+		})
+	})
+
+	Context("Saving", func() {
+		It("should keep global permissions only tenants grant", func() {
+			var buf bytes.Buffer
+			Expect(rbac.Save(&buf, nil)).To(Succeed())
+
+			saved, err := NewRbac(&buf)
+			Expect(err).To(BeNil())
+			Expect(saved.GetPermissions(nil)).To(ContainElement("mod-story"))
+			Expect(saved.CheckTenant([]string{"night-desk"}, times, &times, "MOD_STORY")).To(BeTrue())
+		})
+	})
+
+	Context("Validating", func() {
+		validate := func(policy string) []Issue {
+			p := &Serialize{}
+			Expect(LoadYaml(strings.NewReader(policy), p)).To(Succeed())
+			return Errors(ValidatePolicy(p, nil))
+		}
+
+		It("should report undeclared permissions in the tenant", func() {
+			issues := validate(`
+tenants:
+ times:
+  roles:
+   editor:
+    permissions:
+    - mod-story`)
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Kind).To(Equal(UndeclaredPermission))
+			Expect(issues[0].Tenant).To(Equal("times"))
+			Expect(issues[0].Message).To(Equal("tenant times role editor has permission mod-story which isn't in permissions"))
+		})
+		It("should not allow grants in other domains", func() {
+			issues := validate(`
+permissions:
+- mod-story
+tenants:
+ times:
+  roles:
+   editor:
+    domains:
+     mail:
+     - mod-story`)
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Kind).To(Equal(InvalidTenant))
+		})
+		It("should not allow a tenant for every domain", func() {
+			issues := validate(`
+tenants:
+ "*":
+  roles: {}`)
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Kind).To(Equal(InvalidTenant))
+		})
+		It("should not load an invalid tenant", func() {
+			_, err := NewRbac(strings.NewReader(`
+tenants:
+ times:
+  roles:
+   editor:
+    parents:
+    - missing`))
+			Expect(err).To(MatchError("Invalid policy, tenant times role editor has parent missing which isn't a role"))
+		})
+	})
+})
@@ -0,0 +1,276 @@
+package gorbac
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// Issue kinds reported by ValidatePolicy
+const (
+	UndeclaredPermission    = "undeclared-permission"
+	DanglingParent          = "dangling-parent"
+	Cycle                   = "cycle"
+	UnusedPermission        = "unused-permission"
+	UnrequestablePermission = "unrequestable-permission"
+	EmptyRole               = "empty-role"
+	EmptyDomain             = "empty-domain"
+	InvalidTenant           = "invalid-tenant"
+)
+
+// Issue is a problem found in a policy
+// Errors stop the policy from loading, the rest are warnings
+type Issue struct {
+	Kind       string
+	Error      bool
+	Role       string
+	Permission string
+	// Tenant is set for issues in a tenant's roles
+	Tenant  string
+	Message string
+}
+
+func (i Issue) String() string {
+	level := "warning"
+	if i.Error {
+		level = "error"
+	}
+	return fmt.Sprintf("%s: %s: %s", level, i.Kind, i.Message)
+}
+
+// Errors returns only the issues which stop a policy loading
+func Errors(issues []Issue) []Issue {
+	ret := make([]Issue, 0)
+	for _, i := range issues {
+		if i.Error {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
+
+// ValidatePolicy checks a policy for undeclared permissions, dangling parents, inheritance cycles,
+// unused permissions, roles with no effective grants, errors in the tenants' roles and, if requestable is not nil, permissions
+// which none of the requestable values (the RBAC enum) can ever ask for
+func ValidatePolicy(policy *Serialize, requestable []string) []Issue {
+	issues := make([]Issue, 0)
+
+	declared := make(map[string]bool)
+	for _, pid := range policy.Permissions {
+		declared[pid] = true
+	}
+
+	used := make(map[string]bool)
+	names := roleNames(policy)
+
+	for _, name := range names {
+		role := policy.Roles[name]
+		for _, pid := range role.Permissions {
+			used[pid] = true
+			if !declared[pid] {
+				issues = append(issues, Issue{
+					Kind:       UndeclaredPermission,
+					Error:      true,
+					Role:       name,
+					Permission: pid,
+					Message:    fmt.Sprintf("role %s has permission %s which isn't in permissions", name, pid),
+				})
+			}
+		}
+		for _, g := range role.Grants() {
+			used[g.Permission] = true
+			if !declared[g.Permission] {
+				issues = append(issues, Issue{
+					Kind:       UndeclaredPermission,
+					Error:      true,
+					Role:       name,
+					Permission: g.Permission,
+					Message:    fmt.Sprintf("role %s has permission %s in %s which isn't in permissions", name, g.Permission, g.Domain),
+				})
+			}
+		}
+		if _, ok := role.Domains[""]; ok {
+			issues = append(issues, Issue{
+				Kind:    EmptyDomain,
+				Error:   true,
+				Role:    name,
+				Message: fmt.Sprintf("role %s grants permissions in a domain with no name", name),
+			})
+		}
+		for _, parent := range role.Parents {
+			if _, ok := policy.Roles[parent]; !ok {
+				issues = append(issues, Issue{
+					Kind:    DanglingParent,
+					Error:   true,
+					Role:    name,
+					Message: fmt.Sprintf("role %s has parent %s which isn't a role", name, parent),
+				})
+			}
+		}
+	}
+
+	// the tenants' roles can use the global permissions too
+	for _, t := range policy.Tenants {
+		for _, role := range t.Roles {
+			for _, pid := range role.Permissions {
+				used[pid] = true
+			}
+		}
+	}
+
+	for _, cycle := range cycles(policy, names) {
+		issues = append(issues, Issue{
+			Kind:    Cycle,
+			Error:   true,
+			Role:    cycle[0],
+			Message: fmt.Sprintf("roles inherit from themselves %s", strings.Join(cycle, " -> ")),
+		})
+	}
+
+	for _, pid := range policy.Permissions {
+		if !used[pid] {
+			issues = append(issues, Issue{
+				Kind:       UnusedPermission,
+				Permission: pid,
+				Message:    fmt.Sprintf("permission %s isn't granted by any role", pid),
+			})
+		}
+		if requestable != nil && !isRequestable(pid, requestable) {
+			issues = append(issues, Issue{
+				Kind:       UnrequestablePermission,
+				Permission: pid,
+				Message:    fmt.Sprintf("permission %s can't be requested by any RBAC value", pid),
+			})
+		}
+	}
+
+	for _, name := range names {
+		if len(grants(policy, name)) == 0 && !grantsInDomain(policy, name) {
+			issues = append(issues, Issue{
+				Kind:    EmptyRole,
+				Role:    name,
+				Message: fmt.Sprintf("role %s doesn't grant any permissions", name),
+			})
+		}
+	}
+
+	return append(issues, validateTenants(policy)...)
+}
+
+// Validate checks the current policy, see ValidatePolicy
+func (r *Rbac) Validate(requestable []string) []Issue {
+	return ValidatePolicy(r.policy(), requestable)
+}
+
+func roleNames(policy *Serialize) []string {
+	names := make([]string, 0, len(policy.Roles))
+	for name := range policy.Roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isRequestable is true if Check or CheckDomain could ask for the permission
+func isRequestable(pid string, requestable []string) bool {
+	for _, v := range requestable {
+		if pid == strcase.ToKebab(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// grants is every permission a role has, including those inherited from its parents
+func grants(policy *Serialize, name string) []string {
+	ret := make([]string, 0)
+	for _, role := range ancestors(policy, name) {
+		for _, pid := range policy.Roles[role].Permissions {
+			ret = appendIfMissing(ret, &pid)
+		}
+	}
+	return ret
+}
+
+// grantsInDomain is true if the role or one of its parents grants anything in any domain
+func grantsInDomain(policy *Serialize, name string) bool {
+	for _, role := range ancestors(policy, name) {
+		if len(policy.Roles[role].Domains) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ancestors is the role and everything it inherits from, it is safe with cycles and dangling parents
+func ancestors(policy *Serialize, name string) []string {
+	seen := map[string]bool{}
+	ret := make([]string, 0)
+
+	var walk func(string)
+	walk = func(n string) {
+		if seen[n] {
+			return
+		}
+		seen[n] = true
+		if _, ok := policy.Roles[n]; !ok {
+			return
+		}
+		ret = append(ret, n)
+		for _, p := range policy.Roles[n].Parents {
+			walk(p)
+		}
+	}
+	walk(name)
+
+	return ret
+}
+
+// cycles finds each inheritance cycle once, as the path from its first role back to itself
+func cycles(policy *Serialize, names []string) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[string]int)
+	ret := make([][]string, 0)
+	path := make([]string, 0)
+
+	var walk func(string)
+	walk = func(n string) {
+		state[n] = visiting
+		path = append(path, n)
+
+		for _, p := range policy.Roles[n].Parents {
+			if _, ok := policy.Roles[p]; !ok {
+				continue
+			}
+			switch state[p] {
+			case visiting:
+				for i := range path {
+					if path[i] == p {
+						cycle := append([]string{}, path[i:]...)
+						ret = append(ret, append(cycle, p))
+					}
+				}
+			case unvisited:
+				walk(p)
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[n] = done
+	}
+
+	for _, n := range names {
+		if state[n] == unvisited {
+			walk(n)
+		}
+	}
+
+	return ret
+}
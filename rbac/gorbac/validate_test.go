@@ -0,0 +1,161 @@
+package gorbac
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+
+	kinds := func(issues []Issue) []string {
+		ret := make([]string, 0)
+		for _, i := range issues {
+			ret = append(ret, i.Kind)
+		}
+		return ret
+	}
+
+	load := func(yaml string) *Serialize {
+		s := &Serialize{}
+		Expect(LoadYaml(strings.NewReader(yaml), s)).To(BeNil())
+		return s
+	}
+
+	Context("Clean policy", func() {
+		It("should have no issues", func() {
+			s := load(`
+permissions:
+- mod-story
+- rbac-query
+roles:
+ editor:
+  domains:
+   the-bugle:
+   - mod-story
+ chief:
+  parents:
+  - editor
+  permissions:
+  - rbac-query`)
+
+			Expect(ValidatePolicy(s, []string{"MOD_STORY", "RBAC_QUERY"})).To(BeEmpty())
+		})
+	})
+	Context("Undeclared permission", func() {
+		It("should be an error", func() {
+			issues := ValidatePolicy(load(`
+roles:
+ editor:
+  permissions:
+  - mod-story`), nil)
+			Expect(kinds(issues)).To(Equal([]string{UndeclaredPermission}))
+			Expect(issues[0].Error).To(BeTrue())
+			Expect(issues[0].Role).To(Equal("editor"))
+			Expect(issues[0].Permission).To(Equal("mod-story"))
+		})
+	})
+	Context("Dangling parent", func() {
+		It("should be an error", func() {
+			issues := ValidatePolicy(load(`
+permissions:
+- mod-story
+roles:
+ editor:
+  permissions:
+  - mod-story
+  parents:
+  - invalid`), nil)
+			Expect(kinds(issues)).To(Equal([]string{DanglingParent}))
+			Expect(Errors(issues)).To(HaveLen(1))
+		})
+	})
+	Context("Inheritance cycle", func() {
+		It("should be reported once", func() {
+			issues := ValidatePolicy(load(`
+permissions:
+- mod-story
+roles:
+ a:
+  permissions:
+  - mod-story
+  parents:
+  - b
+ b:
+  parents:
+  - c
+ c:
+  parents:
+  - a`), nil)
+			Expect(kinds(issues)).To(Equal([]string{Cycle}))
+			Expect(issues[0].Message).To(ContainSubstring("a -> b -> c -> a"))
+		})
+	})
+	Context("Role is its own parent", func() {
+		It("should be a cycle", func() {
+			issues := ValidatePolicy(load(`
+permissions:
+- mod-story
+roles:
+ a:
+  permissions:
+  - mod-story
+  parents:
+  - a`), nil)
+			Expect(kinds(issues)).To(Equal([]string{Cycle}))
+		})
+	})
+	Context("Unused and unrequestable permissions", func() {
+		It("should be warnings", func() {
+			issues := ValidatePolicy(load(`
+permissions:
+- mod-story
+- del-text
+roles:
+ editor:
+  permissions:
+  - mod-story`), []string{"MOD_STORY"})
+			Expect(kinds(issues)).To(Equal([]string{UnusedPermission, UnrequestablePermission}))
+			Expect(Errors(issues)).To(BeEmpty())
+		})
+	})
+	Context("Role without grants", func() {
+		It("should be a warning", func() {
+			issues := ValidatePolicy(load(`
+permissions:
+- mod-story
+roles:
+ editor:
+  permissions:
+  - mod-story
+ inherits:
+  parents:
+  - editor
+ empty: {}`), nil)
+			Expect(kinds(issues)).To(Equal([]string{EmptyRole}))
+			Expect(issues[0].Role).To(Equal("empty"))
+		})
+	})
+	Context("Loading a policy with errors", func() {
+		It("should fail", func() {
+			_, err := NewRbac(strings.NewReader(`
+permissions:
+- mod-story
+roles:
+ a:
+  permissions:
+  - mod-story
+  parents:
+  - a`))
+			Expect(err).To(HaveOccurred())
+
+			_, err = NewRbac(strings.NewReader(`
+roles:
+ a:
+  permissions:
+  - mod-story`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
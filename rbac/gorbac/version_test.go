@@ -0,0 +1,151 @@
+package gorbac
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/JeremyMarshall/gqlgen-jwt/rbac/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Version", func() {
+
+	var (
+		rbac *Rbac
+	)
+
+	expect := func(v int) *types.Mutation {
+		return &types.Mutation{ExpectedVersion: &v}
+	}
+
+	BeforeEach(func() {
+		var err error
+		rbac, err = NewRbac(strings.NewReader(`
+version: 3
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text`))
+		Expect(err).To(BeNil())
+	})
+
+	Context("Loaded policy", func() {
+		It("should have the version from the yaml", func() {
+			Expect(rbac.Version()).To(Equal(3))
+
+			roles, err := rbac.GetRoles(nil)
+			Expect(err).To(BeNil())
+			Expect(roles["editor"].Version).To(Equal(3))
+		})
+	})
+	Context("Mutations with the current version", func() {
+		It("should apply and bump the version", func() {
+			r := "writer"
+			p := "add-text"
+
+			role, err := rbac.UpsertRole(&r, []*string{&p}, nil, expect(3))
+			Expect(err).To(BeNil())
+			Expect(role.Version).To(Equal(4))
+
+			_, err = rbac.DeletePermission(&r, &p, expect(4))
+			Expect(err).To(BeNil())
+
+			_, err = rbac.DeleteRole(&r, expect(5))
+			Expect(err).To(BeNil())
+			Expect(rbac.Version()).To(Equal(6))
+		})
+	})
+	Context("Mutations without an expected version", func() {
+		It("should always apply", func() {
+			r := "writer"
+
+			_, err := rbac.UpsertRole(&r, nil, nil, nil)
+			Expect(err).To(BeNil())
+			_, err = rbac.UpsertRole(&r, nil, nil, &types.Mutation{})
+			Expect(err).To(BeNil())
+			Expect(rbac.Version()).To(Equal(5))
+		})
+	})
+	Context("Mutations with a stale version", func() {
+		It("should conflict and change nothing", func() {
+			r := "editor"
+			p := "add-text"
+
+			_, err := rbac.UpsertRole(&r, []*string{&p}, nil, expect(2))
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+			_, err = rbac.DeletePermission(&r, &p, expect(2))
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+			_, err = rbac.DeleteRole(&r, expect(4))
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+			err = rbac.Save(new(bytes.Buffer), expect(2))
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+			err = rbac.Restore(strings.NewReader("roles: {}"), func(fn func(writer io.Writer) error) error {
+				Fail("written")
+				return nil
+			}, expect(2))
+			Expect(errors.Is(err, types.ErrConflict)).To(BeTrue())
+
+			Expect(rbac.Version()).To(Equal(3))
+			Expect(rbac.Check([]string{"editor"}, "add-text")).To(BeTrue())
+		})
+	})
+	Context("Failed mutations", func() {
+		It("should not bump the version", func() {
+			r := "editor"
+			pa := "invalid"
+
+			_, err := rbac.UpsertRole(&r, nil, []*string{&pa}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(rbac.Version()).To(Equal(3))
+		})
+	})
+	Context("Save", func() {
+		It("should write the version", func() {
+			r := "writer"
+			_, err := rbac.UpsertRole(&r, nil, nil, nil)
+			Expect(err).To(BeNil())
+
+			buf := new(bytes.Buffer)
+			Expect(rbac.Save(buf, nil)).To(BeNil())
+
+			s := &Serialize{}
+			Expect(LoadYaml(buf, s)).To(BeNil())
+			Expect(s.Version).To(Equal(4))
+		})
+	})
+	Context("Reload an older policy", func() {
+		It("should still move the version forward", func() {
+			Expect(rbac.Reload(strings.NewReader(`
+version: 1
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text`))).To(BeNil())
+			Expect(rbac.Version()).To(Equal(4))
+		})
+	})
+	Context("Reload a newer policy", func() {
+		It("should take its version", func() {
+			Expect(rbac.Reload(strings.NewReader(`
+version: 10
+permissions:
+- add-text
+roles:
+ editor:
+  permissions:
+  - add-text`))).To(BeNil())
+			Expect(rbac.Version()).To(Equal(10))
+		})
+	})
+})
@@ -0,0 +1,255 @@
+package persist
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	backupSuffix = ".bak"
+	backupStamp  = "20060102T150405.000000000Z"
+)
+
+// File is a policy file which is replaced atomically on write
+// and keeps a rotating set of timestamped backups of its previous contents
+type File struct {
+	Path    string
+	Backups int
+	mutex   *sync.Mutex
+	now     func() time.Time
+	// sum of the contents last written or loaded, guarded by sumMutex
+	sum      [sha256.Size]byte
+	sumMutex *sync.Mutex
+}
+
+func NewFile(path string, backups int) *File {
+	return &File{
+		Path:     path,
+		Backups:  backups,
+		mutex:    &sync.Mutex{},
+		now:      time.Now,
+		sumMutex: &sync.Mutex{},
+	}
+}
+
+func (f *File) Open() (io.ReadCloser, error) {
+	return os.Open(f.Path)
+}
+
+// Write replaces the file with whatever fn writes. The new contents are written
+// to a temp file in the same directory, synced and then renamed over the live file
+// so a failure part way through leaves the previous contents untouched
+func (f *File) Write(fn func(writer io.Writer) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.write(fn)
+}
+
+// List returns the names of the backups, newest first
+func (f *File) List() ([]string, error) {
+	dir, base := filepath.Split(f.Path)
+	if dir == "" {
+		dir = "."
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]string, 0)
+	for _, e := range entries {
+		if !e.IsDir() && f.isBackup(base, e.Name()) {
+			ret = append(ret, e.Name())
+		}
+	}
+
+	// the timestamp sorts lexically
+	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
+	return ret, nil
+}
+
+// Restore hands the contents of the named backup to fn along with write, which replaces the live file as Write does
+// fn writes what it made of the contents, eg with a new version, and should only act on them once write succeeds
+// so a failed write leaves both the file and whatever fn loads them into as they were
+func (f *File) Restore(name string, fn func(reader io.Reader, write func(fn func(writer io.Writer) error) error) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if !f.isBackup(filepath.Base(f.Path), name) {
+		return fmt.Errorf("Backup %s not found", name)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(filepath.Dir(f.Path), name))
+	if err != nil {
+		return fmt.Errorf("Backup %s not found", name)
+	}
+
+	return fn(bytes.NewReader(data), f.write)
+}
+
+func (f *File) isBackup(base, name string) bool {
+	if name != filepath.Base(name) {
+		return false
+	}
+	if !strings.HasPrefix(name, base+".") || !strings.HasSuffix(name, backupSuffix) {
+		return false
+	}
+	stamp := strings.TrimSuffix(strings.TrimPrefix(name, base+"."), backupSuffix)
+	_, err := time.Parse(backupStamp, stamp)
+	return err == nil
+}
+
+func (f *File) write(fn func(writer io.Writer) error) error {
+	dir, base := filepath.Split(f.Path)
+	if dir == "" {
+		dir = "."
+	}
+
+	hash := sha256.New()
+	var sum [sha256.Size]byte
+
+	// only back up once the new contents are safely on disk
+	err := writeAtomic(dir, base, func(writer io.Writer) error {
+		if err := fn(io.MultiWriter(writer, hash)); err != nil {
+			return err
+		}
+		copy(sum[:], hash.Sum(nil))
+		return nil
+	}, f.backup)
+	if err != nil {
+		return err
+	}
+
+	f.setSum(sum)
+	return nil
+}
+
+func (f *File) getSum() [sha256.Size]byte {
+	f.sumMutex.Lock()
+	defer f.sumMutex.Unlock()
+	return f.sum
+}
+
+func (f *File) setSum(sum [sha256.Size]byte) {
+	f.sumMutex.Lock()
+	f.sum = sum
+	f.sumMutex.Unlock()
+}
+
+func (f *File) backup() error {
+	if f.Backups <= 0 {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		// nothing to back up yet
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	dir, base := filepath.Split(f.Path)
+	if dir == "" {
+		dir = "."
+	}
+	name := fmt.Sprintf("%s.%s%s", base, f.now().UTC().Format(backupStamp), backupSuffix)
+
+	err = writeAtomic(dir, name, func(writer io.Writer) error {
+		_, err := writer.Write(data)
+		return err
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	return f.prune()
+}
+
+// prune removes all but the newest Backups backups
+func (f *File) prune() error {
+	backups, err := f.List()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(f.Path)
+	for i := f.Backups; i < len(backups); i++ {
+		if err := os.Remove(filepath.Join(dir, backups[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeAtomic writes to a temp file and renames it over name, calling before (if set)
+// just prior to the rename
+func writeAtomic(dir, name string, fn func(writer io.Writer) error, before func() error) error {
+	tmp, err := ioutil.TempFile(dir, "."+name+".tmp")
+	if err != nil {
+		return err
+	}
+
+	// a no-op once the rename has happened
+	defer os.Remove(tmp.Name())
+
+	if err := fn(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// keep the mode of the file being replaced, temp files are created private
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(filepath.Join(dir, name)); err == nil {
+		mode = info.Mode()
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+
+	if before != nil {
+		if err := before(); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, name)); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// syncDir makes the rename durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	// not all platforms support syncing a directory
+	d.Sync()
+	return nil
+}
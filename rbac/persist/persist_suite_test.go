@@ -0,0 +1,13 @@
+package persist_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPersist(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Persist Suite")
+}
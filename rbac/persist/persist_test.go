@@ -0,0 +1,189 @@
+package persist_test
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/JeremyMarshall/gqlgen-jwt/rbac/persist"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Persist", func() {
+
+	var (
+		dir  string
+		path string
+		file *File
+	)
+
+	write := func(content string) error {
+		return file.Write(func(writer io.Writer) error {
+			_, err := io.WriteString(writer, content)
+			return err
+		})
+	}
+
+	read := func() string {
+		data, err := ioutil.ReadFile(path)
+		Expect(err).To(BeNil())
+		return string(data)
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "persist")
+		Expect(err).To(BeNil())
+
+		path = filepath.Join(dir, "all.yaml")
+		Expect(ioutil.WriteFile(path, []byte("v0"), 0644)).To(BeNil())
+
+		file = NewFile(path, 2)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("Write", func() {
+		Context("Successful write", func() {
+			It("should replace the file and keep a backup", func() {
+				Expect(write("v1")).To(BeNil())
+				Expect(read()).To(Equal("v1"))
+
+				backups, err := file.List()
+				Expect(err).To(BeNil())
+				Expect(backups).To(HaveLen(1))
+			})
+		})
+		Context("Failed write", func() {
+			It("should leave the file untouched", func() {
+				err := file.Write(func(writer io.Writer) error {
+					io.WriteString(writer, "partial")
+					return fmt.Errorf("encode error")
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(read()).To(Equal("v0"))
+
+				backups, err := file.List()
+				Expect(err).To(BeNil())
+				Expect(backups).To(BeEmpty())
+
+				// no temp files left behind
+				entries, err := ioutil.ReadDir(dir)
+				Expect(err).To(BeNil())
+				Expect(entries).To(HaveLen(1))
+			})
+		})
+		Context("More writes than backups", func() {
+			It("should rotate out the oldest", func() {
+				for i := 1; i <= 4; i++ {
+					Expect(write(fmt.Sprintf("v%d", i))).To(BeNil())
+				}
+
+				backups, err := file.List()
+				Expect(err).To(BeNil())
+				Expect(backups).To(HaveLen(2))
+
+				newest, err := ioutil.ReadFile(filepath.Join(dir, backups[0]))
+				Expect(err).To(BeNil())
+				Expect(string(newest)).To(Equal("v3"))
+			})
+		})
+		Context("Backups disabled", func() {
+			It("should not keep any", func() {
+				file = NewFile(path, 0)
+				Expect(write("v1")).To(BeNil())
+
+				backups, err := file.List()
+				Expect(err).To(BeNil())
+				Expect(backups).To(BeEmpty())
+			})
+		})
+		Context("File doesn't exist yet", func() {
+			It("should create it", func() {
+				file = NewFile(filepath.Join(dir, "new.yaml"), 2)
+				Expect(file.Write(func(writer io.Writer) error { return nil })).To(BeNil())
+
+				_, err := os.Stat(filepath.Join(dir, "new.yaml"))
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Restore", func() {
+		Context("Accepted backup", func() {
+			It("should become the live file", func() {
+				Expect(write("v1")).To(BeNil())
+				backups, _ := file.List()
+
+				var seen string
+				err := file.Restore(backups[0], func(reader io.Reader, write func(fn func(writer io.Writer) error) error) error {
+					data, err := ioutil.ReadAll(reader)
+					seen = string(data)
+					if err != nil {
+						return err
+					}
+					return write(func(writer io.Writer) error {
+						_, err := io.WriteString(writer, "restored "+seen)
+						return err
+					})
+				})
+				Expect(err).To(BeNil())
+				Expect(seen).To(Equal("v0"))
+				Expect(read()).To(Equal("restored v0"))
+
+				// the replaced contents are backed up too
+				backups, _ = file.List()
+				Expect(backups).To(HaveLen(2))
+			})
+		})
+		Context("Rejected backup", func() {
+			It("should leave the file untouched", func() {
+				Expect(write("v1")).To(BeNil())
+				backups, _ := file.List()
+
+				err := file.Restore(backups[0], func(reader io.Reader, write func(fn func(writer io.Writer) error) error) error {
+					return fmt.Errorf("invalid policy")
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(read()).To(Equal("v1"))
+			})
+		})
+		Context("Write fails", func() {
+			It("should hand the error to fn", func() {
+				Expect(write("v1")).To(BeNil())
+				backups, _ := file.List()
+
+				var failed error
+				err := file.Restore(backups[0], func(reader io.Reader, write func(fn func(writer io.Writer) error) error) error {
+					// nowhere left to write the temp file
+					Expect(os.RemoveAll(dir)).To(Succeed())
+					failed = write(func(writer io.Writer) error { return nil })
+					return failed
+				})
+				Expect(failed).To(HaveOccurred())
+				Expect(err).To(Equal(failed))
+			})
+		})
+		Context("Unknown backup", func() {
+			It("should fail", func() {
+				err := file.Restore("all.yaml.20200101T000000.000000000Z.bak", func(reader io.Reader, write func(fn func(writer io.Writer) error) error) error {
+					return write(func(writer io.Writer) error { return nil })
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("Path outside the backups", func() {
+			It("should fail", func() {
+				err := file.Restore("../all.yaml", func(reader io.Reader, write func(fn func(writer io.Writer) error) error) error {
+					return write(func(writer io.Writer) error { return nil })
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
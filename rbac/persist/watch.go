@@ -0,0 +1,142 @@
+package persist
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Settle is how long to wait after the last change before reloading
+// Editors and atomic renames generate bursts of events for a single save
+const Settle = 100 * time.Millisecond
+
+// Reloader accepts a new policy, returning an error leaves the current one in place
+type Reloader interface {
+	Reload(reader io.Reader) error
+}
+
+// Watcher reloads the policy whenever the file changes on disk or the process gets a SIGHUP
+type Watcher struct {
+	file     *File
+	reloader Reloader
+	watcher  *fsnotify.Watcher
+	signals  chan os.Signal
+	reloaded func(err error)
+	done     chan struct{}
+	closed   chan struct{}
+}
+
+// Watch starts watching the file, reloaded (if not nil) gets the result of every reload attempt
+func (f *File) Watch(reloader Reloader, reloaded func(err error)) (*Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// watch the directory as the file itself is replaced by a rename
+	if err := watcher.Add(filepath.Dir(f.Path)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	w := &Watcher{
+		file:     f,
+		reloader: reloader,
+		reloaded: reloaded,
+		watcher:  watcher,
+		signals:  make(chan os.Signal, 1),
+		done:     make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+
+	if data, err := ioutil.ReadFile(f.Path); err == nil {
+		f.setSum(sha256.Sum256(data))
+	}
+
+	signal.Notify(w.signals, syscall.SIGHUP)
+
+	go w.run()
+	return w, nil
+}
+
+func (w *Watcher) Close() error {
+	signal.Stop(w.signals)
+	close(w.done)
+	<-w.closed
+	return w.watcher.Close()
+}
+
+func (w *Watcher) run() {
+	defer close(w.closed)
+
+	base := filepath.Base(w.file.Path)
+	settle := time.NewTimer(Settle)
+	settle.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			settle.Stop()
+			return
+		case <-w.signals:
+			// an explicit request always reloads
+			w.reload(true)
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Base(event.Name) == base && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+				settle.Reset(Settle)
+			}
+		case <-settle.C:
+			w.reload(false)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("watching %s: %v", w.file.Path, err)
+		}
+	}
+}
+
+func (w *Watcher) reload(force bool) {
+	data, err := ioutil.ReadFile(w.file.Path)
+	if err != nil {
+		w.report(err)
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	if !force && sum == w.file.getSum() {
+		// our own save or a touch, nothing to do
+		return
+	}
+
+	if err := w.reloader.Reload(bytes.NewReader(data)); err != nil {
+		w.report(err)
+		return
+	}
+
+	w.file.setSum(sum)
+	w.report(nil)
+}
+
+func (w *Watcher) report(err error) {
+	if err != nil {
+		log.Printf("reloading %s, keeping current policy: %v", w.file.Path, err)
+	} else {
+		log.Printf("reloaded %s", w.file.Path)
+	}
+	if w.reloaded != nil {
+		w.reloaded(err)
+	}
+}
@@ -0,0 +1,126 @@
+package persist_test
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	. "github.com/JeremyMarshall/gqlgen-jwt/rbac/persist"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type reloader struct {
+	mutex  sync.Mutex
+	loaded []string
+}
+
+func (r *reloader) Reload(reader io.Reader) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if string(data) == "error" {
+		return fmt.Errorf("invalid policy")
+	}
+	r.mutex.Lock()
+	r.loaded = append(r.loaded, string(data))
+	r.mutex.Unlock()
+	return nil
+}
+
+func (r *reloader) Loaded() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]string{}, r.loaded...)
+}
+
+var _ = Describe("Watch", func() {
+
+	var (
+		dir     string
+		path    string
+		file    *File
+		rl      *reloader
+		results chan error
+		watcher *Watcher
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "watch")
+		Expect(err).To(BeNil())
+
+		path = filepath.Join(dir, "all.yaml")
+		Expect(ioutil.WriteFile(path, []byte("v0"), 0644)).To(BeNil())
+
+		file = NewFile(path, 2)
+		rl = &reloader{}
+		results = make(chan error, 10)
+
+		watcher, err = file.Watch(rl, func(err error) { results <- err })
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		watcher.Close()
+		os.RemoveAll(dir)
+	})
+
+	Context("File edited", func() {
+		It("should reload", func() {
+			Expect(ioutil.WriteFile(path, []byte("v1"), 0644)).To(BeNil())
+
+			Eventually(results, time.Second).Should(Receive(BeNil()))
+			Expect(rl.Loaded()).To(Equal([]string{"v1"}))
+		})
+	})
+	Context("File replaced by rename", func() {
+		It("should reload", func() {
+			tmp := filepath.Join(dir, "tmp")
+			Expect(ioutil.WriteFile(tmp, []byte("v1"), 0644)).To(BeNil())
+			Expect(os.Rename(tmp, path)).To(BeNil())
+
+			Eventually(results, time.Second).Should(Receive(BeNil()))
+			Expect(rl.Loaded()).To(Equal([]string{"v1"}))
+		})
+	})
+	Context("Invalid policy", func() {
+		It("should report the error", func() {
+			Expect(ioutil.WriteFile(path, []byte("error"), 0644)).To(BeNil())
+
+			Eventually(results, time.Second).Should(Receive(HaveOccurred()))
+			Expect(rl.Loaded()).To(BeEmpty())
+		})
+	})
+	Context("Own save", func() {
+		It("should not reload", func() {
+			Expect(file.Write(func(writer io.Writer) error {
+				_, err := io.WriteString(writer, "v1")
+				return err
+			})).To(BeNil())
+
+			Consistently(results, 3*Settle).ShouldNot(Receive())
+		})
+	})
+	Context("Other files in the directory", func() {
+		It("should not reload", func() {
+			Expect(ioutil.WriteFile(filepath.Join(dir, "other.yaml"), []byte("v1"), 0644)).To(BeNil())
+
+			Consistently(results, 3*Settle).ShouldNot(Receive())
+		})
+	})
+	Context("SIGHUP", func() {
+		It("should reload even if unchanged", func() {
+			Expect(syscall.Kill(os.Getpid(), syscall.SIGHUP)).To(BeNil())
+
+			Eventually(results, time.Second).Should(Receive(BeNil()))
+			Expect(rl.Loaded()).To(Equal([]string{"v0"}))
+		})
+	})
+})
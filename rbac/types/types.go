@@ -1,29 +1,306 @@
 package types
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"time"
 )
 
+// ErrConflict is returned when a mutation expects a different policy version to the current one
+var ErrConflict = errors.New("Policy version conflict")
+
 type Role struct {
-	Permissions []string `yaml:"permissions"`
-	Parents     []string `yaml:"parents"`
+	Permissions []string `yaml:"permissions" json:"permissions"`
+	Parents     []string `yaml:"parents" json:"parents"`
+	// Domains are the permissions granted within a domain, keyed by the domain or AnyDomain
+	// They are checked by CheckDomain, Permissions only by Check
+	Domains map[string][]string `yaml:"domains,omitempty" json:"domains,omitempty"`
+	// Version is the policy version the role was read at
+	Version int `yaml:"-" json:"-"`
+}
+
+// AnyDomain is the Domains key granting permissions in every domain
+const AnyDomain = "*"
+
+// DomainSeparator splits hierarchical domains, eg acme/the-bugle/sport
+// Permissions granted in a domain are granted in every domain below it
+const DomainSeparator = "/"
+
+// DomainPath is the domain then each domain above it, eg acme/the-bugle, acme
+func DomainPath(domain string) []string {
+	ret := []string{domain}
+	for i := strings.LastIndex(domain, DomainSeparator); i > 0; i = strings.LastIndex(domain, DomainSeparator) {
+		domain = domain[:i]
+		ret = append(ret, domain)
+	}
+	return ret
+}
+
+// InDomain is true if domain is within, or is, ancestor
+func InDomain(domain string, ancestor string) bool {
+	return domain == ancestor || strings.HasPrefix(domain, ancestor+DomainSeparator)
+}
+
+// Grant is a permission within a domain
+type Grant struct {
+	Domain     string `json:"domain"`
+	Permission string `json:"permission"`
+}
+
+// Clone is a copy sharing nothing with the role
+func (r Role) Clone() Role {
+	ret := Role{
+		Permissions: append([]string{}, r.Permissions...),
+		Parents:     append([]string{}, r.Parents...),
+		Version:     r.Version,
+	}
+	if r.Domains != nil {
+		ret.Domains = make(map[string][]string, len(r.Domains))
+		for d, p := range r.Domains {
+			ret.Domains[d] = append([]string{}, p...)
+		}
+	}
+	return ret
+}
+
+// Grants are the role's Domains, sorted by domain then permission
+func (r Role) Grants() []Grant {
+	ret := make([]Grant, 0)
+	for d, perms := range r.Domains {
+		for _, p := range perms {
+			ret = append(ret, Grant{Domain: d, Permission: p})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Domain != ret[j].Domain {
+			return ret[i].Domain < ret[j].Domain
+		}
+		return ret[i].Permission < ret[j].Permission
+	})
+	return ret
+}
+
+// Mutation describes who is changing the policy
+type Mutation struct {
+	// User is recorded in the policy history
+	User string
+	// ExpectedVersion if set must match the policy version for the mutation to apply
+	ExpectedVersion *int
+}
+
+// CheckVersion returns ErrConflict if the mutation expects a version other than current
+func (m *Mutation) CheckVersion(current int) error {
+	if m == nil || m.ExpectedVersion == nil || *m.ExpectedVersion == current {
+		return nil
+	}
+	return fmt.Errorf("%w, expected version %d but policy is at %d", ErrConflict, *m.ExpectedVersion, current)
+}
+
+type ChangeOp string
+
+const (
+	ChangeUpsertRole       ChangeOp = "UPSERT_ROLE"
+	ChangeDeleteRole       ChangeOp = "DELETE_ROLE"
+	ChangeDeletePermission ChangeOp = "DELETE_PERMISSION"
+	// ChangeRemovePermission takes Permission out of the policy and every role
+	ChangeRemovePermission ChangeOp = "REMOVE_PERMISSION"
+)
+
+// Change is one step of a batch applied by ApplyChanges
+type Change struct {
+	Op   ChangeOp
+	Name string
+	// Permissions, Parents and Domains are added by ChangeUpsertRole
+	Permissions []string
+	Parents     []string
+	Domains     map[string][]string
+	// Permission is removed by ChangeDeletePermission and ChangeRemovePermission
+	Permission string
+	// Domain is set for ChangeDeletePermission to remove Permission from the role's grants in that domain
+	Domain string
+	// Tenant is set to change the tenant's roles rather than the global ones
+	Tenant string
+}
+
+// DomainPlaceholder is replaced with the domain in role templates
+const DomainPlaceholder = "{domain}"
+
+// ExpandTemplate is the name, permission or parent for a domain
+func ExpandTemplate(template string, domain string) string {
+	return strings.Replace(template, DomainPlaceholder, domain, -1)
+}
+
+// ProvisionChanges are the changes creating a domain's roles from the templates
+// Roles come before those which have them as parents so each upsert finds its parents
+func ProvisionChanges(templates map[string]Role, domain string) []Change {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret := make([]Change, 0, len(templates))
+	done := make(map[string]bool)
+
+	var add func(string)
+	add = func(name string) {
+		if done[name] {
+			return
+		}
+		// set first so a cycle stops here, applying the changes then reports it
+		done[name] = true
+
+		t := templates[name]
+		for _, p := range t.Parents {
+			if _, ok := templates[p]; ok {
+				add(p)
+			}
+		}
+
+		c := Change{
+			Op:          ChangeUpsertRole,
+			Name:        ExpandTemplate(name, domain),
+			Permissions: make([]string, 0, len(t.Permissions)),
+			Parents:     make([]string, 0, len(t.Parents)),
+		}
+		for _, p := range t.Permissions {
+			c.Permissions = append(c.Permissions, ExpandTemplate(p, domain))
+		}
+		for _, p := range t.Parents {
+			c.Parents = append(c.Parents, ExpandTemplate(p, domain))
+		}
+		// the permissions are declared once for every domain so only the keys change
+		if t.Domains != nil {
+			c.Domains = make(map[string][]string, len(t.Domains))
+			for d, perms := range t.Domains {
+				key := ExpandTemplate(d, domain)
+				c.Domains[key] = append(c.Domains[key], perms...)
+			}
+		}
+		ret = append(ret, c)
+	}
+
+	for _, name := range names {
+		add(name)
+	}
+	return ret
+}
+
+type Format string
+
+const (
+	FormatYAML Format = "YAML"
+	FormatJSON Format = "JSON"
+)
+
+type GraphFormat string
+
+const (
+	GraphDOT     GraphFormat = "DOT"
+	GraphMermaid GraphFormat = "MERMAID"
+	GraphJSON    GraphFormat = "JSON"
+)
+
+type ImportMode string
+
+const (
+	// ImportReplace makes the document the whole policy
+	ImportReplace ImportMode = "REPLACE"
+	// ImportMerge adds the document's roles, permissions and parents to the policy
+	ImportMerge ImportMode = "MERGE"
+)
+
+// ImportResult is what an import changed, or would change for a dry run
+type ImportResult struct {
+	DryRun bool
+	// Version is the policy version after the import
+	Version int
+	Diff    PolicyDiff
+}
+
+// RoleGrant is a role granting a permission
+type RoleGrant struct {
+	Role string
+	// Path is the inheritance from Role to the role with the permission, just Role if it is granted directly
+	Path []string
+}
+
+// Revision is an entry in the policy history
+type Revision struct {
+	Version int
+	User    string
+	Time    time.Time
+	Change  string
+}
+
+// RoleDiff is how a role differs between two versions of the policy
+type RoleDiff struct {
+	Name               string
+	AddedPermissions   []string
+	RemovedPermissions []string
+	AddedParents       []string
+	RemovedParents     []string
+	AddedGrants        []Grant
+	RemovedGrants      []Grant
+}
+
+// PolicyDiff is what changed between two versions of the policy
+type PolicyDiff struct {
+	AddedRoles         []string
+	RemovedRoles       []string
+	ChangedRoles       []RoleDiff
+	AddedPermissions   []string
+	RemovedPermissions []string
 }
 
 type Rbac interface {
 	RbacQuery
 	RbacMutate
+	RbacTenants
 	Check(roles []string, permission string) bool
 	CheckDomain(roles []string, domain *string, permission string) bool
+	// EffectivePermissions is every permission the roles have through inheritance, within domain if set
+	EffectivePermissions(roles []string, domain *string) ([]string, error)
 }
 
 type RbacQuery interface {
 	GetPermissions(name *string) ([]string, error)
 	GetRoles(name *string) (map[string]Role, error)
+	Version() int
+	History() ([]Revision, error)
+	Diff(from int, to int) (PolicyDiff, error)
+	Export(writer io.Writer, format Format) error
+	// RolesGranting is every role which passes Check or CheckDomain for the permission
+	RolesGranting(permission string, domain *string) ([]RoleGrant, error)
+	RoleGraph(writer io.Writer, format GraphFormat) error
+	// Templates are the roles created for each new domain, named with DomainPlaceholder
+	Templates() (map[string]Role, error)
+}
+
+// RbacTenants are the tenants' own roles, layered over the global policy
+// A tenant's roles only grant permissions within the tenant's domain
+type RbacTenants interface {
+	Tenants() ([]string, error)
+	GetTenantRoles(tenant string, name *string) (map[string]Role, error)
+	GetTenantPermissions(tenant string) ([]string, error)
+	UpsertTenantRole(tenant string, name *string, perms []*string, parents []*string, m *Mutation) (Role, error)
+	DeleteTenantRole(tenant string, name *string, m *Mutation) (bool, error)
+	// CheckTenant is CheckDomain against the tenant's roles, false for a domain outside the tenant
+	CheckTenant(roles []string, tenant string, domain *string, permission string) bool
 }
 type RbacMutate interface {
-	UpsertRole(name *string, perms []*string, parents []*string) (Role, error)
-	DeleteRole(name *string) (bool, error)
-	DeletePermission(name *string, permission *string) (bool, error)
+	UpsertRole(name *string, perms []*string, parents []*string, m *Mutation) (Role, error)
+	DeleteRole(name *string, m *Mutation) (bool, error)
+	DeletePermission(name *string, permission *string, m *Mutation) (bool, error)
+	ApplyChanges(changes []Change, m *Mutation) (map[string]Role, error)
 	Load() error
-	Save(writer io.Writer) error
+	Reload(reader io.Reader) error
+	// Restore is Reload, only making the policy current once write succeeds
+	Restore(reader io.Reader, write func(fn func(writer io.Writer) error) error, m *Mutation) error
+	Rollback(version int, m *Mutation) error
+	Import(reader io.Reader, mode ImportMode, dryRun bool, m *Mutation) (ImportResult, error)
+	Save(writer io.Writer, m *Mutation) error
 }